@@ -0,0 +1,143 @@
+package client
+
+type (
+	// AccessReviewInterface checks whether requests would be allowed, using
+	// the authorizers of the API server.
+	AccessReviewInterface interface {
+		// CreateSelfSubjectAccessReview checks whether the user of the client
+		// may make a request.
+		CreateSelfSubjectAccessReview(item *SelfSubjectAccessReview) (*SelfSubjectAccessReview, error)
+		// CreateSubjectAccessReview checks whether any user may make a request.
+		CreateSubjectAccessReview(item *SubjectAccessReview) (*SubjectAccessReview, error)
+	}
+
+	// SelfSubjectAccessReview checks whether or not the current user can perform
+	// an action. Not filling in a spec.namespace means "in all namespaces".
+	SelfSubjectAccessReview struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec holds information about the request being evaluated. user and
+		// groups must be empty.
+		Spec SelfSubjectAccessReviewSpec `json:"spec"`
+
+		// Status is filled in by the server and indicates whether the request
+		// is allowed or not.
+		Status *SubjectAccessReviewStatus `json:"status,omitempty"`
+	}
+
+	// SelfSubjectAccessReviewSpec is a description of the access request.
+	// Exactly one of ResourceAttributes and NonResourceAttributes must be set.
+	SelfSubjectAccessReviewSpec struct {
+		// ResourceAttributes describes information for a resource access request.
+		ResourceAttributes *ResourceAttributes `json:"resourceAttributes,omitempty"`
+		// NonResourceAttributes describes information for a non-resource access request.
+		NonResourceAttributes *NonResourceAttributes `json:"nonResourceAttributes,omitempty"`
+	}
+
+	// SubjectAccessReview checks whether or not a user or group can perform an action.
+	SubjectAccessReview struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec holds information about the request being evaluated.
+		Spec SubjectAccessReviewSpec `json:"spec"`
+
+		// Status is filled in by the server and indicates whether the request
+		// is allowed or not.
+		Status *SubjectAccessReviewStatus `json:"status,omitempty"`
+	}
+
+	// SubjectAccessReviewSpec is a description of the access request.
+	// Exactly one of ResourceAttributes and NonResourceAttributes must be set.
+	SubjectAccessReviewSpec struct {
+		// ResourceAttributes describes information for a resource access request.
+		ResourceAttributes *ResourceAttributes `json:"resourceAttributes,omitempty"`
+		// NonResourceAttributes describes information for a non-resource access request.
+		NonResourceAttributes *NonResourceAttributes `json:"nonResourceAttributes,omitempty"`
+		// User is the user you're testing for. If you specify "User" but not
+		// "Groups", then is it interpreted as "What if User were not a member
+		// of any groups".
+		User string `json:"user,omitempty"`
+		// Groups is the groups you're testing for.
+		Groups []string `json:"groups,omitempty"`
+		// Extra corresponds to the user.Info.GetExtra() method from the
+		// authenticator. Since that is input to the authorizer it needs a
+		// reflection here.
+		Extra map[string][]string `json:"extra,omitempty"`
+		// UID information about the requesting user.
+		UID string `json:"uid,omitempty"`
+	}
+
+	// ResourceAttributes includes the authorization attributes available for
+	// resource requests to the Authorizer interface.
+	ResourceAttributes struct {
+		// Namespace is the namespace of the action being requested. "" means
+		// all namespaces for namespaced resources, and is required to be ""
+		// for cluster scoped resources.
+		Namespace string `json:"namespace,omitempty"`
+		// Verb is a kubernetes resource API verb, like: get, list, watch,
+		// create, update, delete, proxy. "*" means all.
+		Verb string `json:"verb,omitempty"`
+		// Group is the API Group of the Resource. "*" means all.
+		Group string `json:"group,omitempty"`
+		// Version is the API Version of the Resource. "*" means all.
+		Version string `json:"version,omitempty"`
+		// Resource is one of the existing resource types. "*" means all.
+		Resource string `json:"resource,omitempty"`
+		// Subresource is one of the existing resource types. "" means none.
+		Subresource string `json:"subresource,omitempty"`
+		// Name is the name of the resource being requested for a "get" or
+		// deleted for a "delete". "" (empty) means all.
+		Name string `json:"name,omitempty"`
+	}
+
+	// NonResourceAttributes includes the authorization attributes available
+	// for non-resource requests to the Authorizer interface.
+	NonResourceAttributes struct {
+		// Path is the URL path of the request.
+		Path string `json:"path,omitempty"`
+		// Verb is the standard HTTP verb.
+		Verb string `json:"verb,omitempty"`
+	}
+
+	// SubjectAccessReviewStatus is the result of an access review.
+	SubjectAccessReviewStatus struct {
+		// Allowed is required. True if the action would be allowed, false otherwise.
+		Allowed bool `json:"allowed"`
+		// Denied is optional. True if the action would be denied, otherwise
+		// false. If both allowed is false and denied is false, then the
+		// authorizer has no opinion on whether to authorize the action.
+		Denied bool `json:"denied,omitempty"`
+		// Reason is optional. It indicates why a request was allowed or denied.
+		Reason string `json:"reason,omitempty"`
+		// EvaluationError is an indication that some error occurred during the
+		// authorization check. It is entirely possible to get an error and be
+		// able to continue determine authorization status in spite of it.
+		EvaluationError string `json:"evaluationError,omitempty"`
+	}
+)
+
+// NewSelfSubjectAccessReview creates a new SelfSubjectAccessReview struct
+// for a resource request.
+func NewSelfSubjectAccessReview(attrs ResourceAttributes) *SelfSubjectAccessReview {
+	return &SelfSubjectAccessReview{
+		TypeMeta: NewTypeMeta("SelfSubjectAccessReview", "authorization.k8s.io/v1"),
+		Spec: SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &attrs,
+		},
+	}
+}
+
+// NewSubjectAccessReview creates a new SubjectAccessReview struct for a
+// resource request by the user.
+func NewSubjectAccessReview(user string, groups []string, attrs ResourceAttributes) *SubjectAccessReview {
+	return &SubjectAccessReview{
+		TypeMeta: NewTypeMeta("SubjectAccessReview", "authorization.k8s.io/v1"),
+		Spec: SubjectAccessReviewSpec{
+			ResourceAttributes: &attrs,
+			User:               user,
+			Groups:             groups,
+		},
+	}
+}
@@ -3,20 +3,11 @@ package client
 type (
 	// Client is an interface that represents a kubernetes client
 	Client interface {
-		ConfigMapInterface
-		DaemonSetInterface
-		DeploymentInterface
-		EndpointsInterface
-		HorizontalPodAutoscalerInterface
-		IngressInterface
-		JobInterface
-		NamespaceInterface
-		NodeInterface
-		PodInterface
-		ReplicaSetInterface
-		SecretInterface
-		ServiceAccountInterface
-		ServiceInterface
+		TypedClient
+		DiscoveryInterface
+		DynamicInterface
+		AccessReviewInterface
+		TokenReviewInterface
 	}
 
 	ListOptions struct {
@@ -27,5 +18,8 @@ type (
 	WatchOptions struct {
 		ListOptions
 		ResourceVersion string
+		// TimeoutSeconds limits the duration of the watch. The server closes
+		// the watch once it has elapsed. Zero uses the server default.
+		TimeoutSeconds int64
 	}
 )
@@ -1,11 +1,32 @@
 package client
 
+import "encoding/json"
+
 // Common object elements
 
+// Values of ConditionStatus
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+const (
+	// DeletePropagationOrphan orphans the dependents.
+	DeletePropagationOrphan DeletionPropagation = "Orphan"
+	// DeletePropagationBackground deletes the object immediately and lets
+	// the garbage collector delete the dependents in the background.
+	DeletePropagationBackground DeletionPropagation = "Background"
+	// DeletePropagationForeground deletes the dependents before the object
+	// itself is removed.
+	DeletePropagationForeground DeletionPropagation = "Foreground"
+)
+
 type (
-	UID             string
-	FinalizerName   string
-	ConditionStatus string
+	UID                 string
+	FinalizerName       string
+	ConditionStatus     string
+	DeletionPropagation string
 
 	TypeMeta struct {
 		Kind       string `json:"kind,omitempty"`
@@ -13,16 +34,61 @@ type (
 	}
 
 	ObjectMeta struct {
-		Name              string            `json:"name,omitempty"`
-		Namespace         string            `json:"namespace,omitempty"`
-		SelfLink          string            `json:"selfLink,omitempty"`
-		UID               UID               `json:"uid,omitempty"`
-		ResourceVersion   string            `json:"resourceVersion,omitempty"`
-		CreationTimestamp *Time             `json:"creationTimestamp,omitempty"`
-		DeletionTimestamp *Time             `json:"deletionTimestamp,omitempty"`
-		Generation        int64             `json:"generation,omitempty"`
-		Labels            map[string]string `json:"labels,omitempty"`
-		Annotations       map[string]string `json:"annotations,omitempty"`
+		Name                       string               `json:"name,omitempty"`
+		GenerateName               string               `json:"generateName,omitempty"`
+		Namespace                  string               `json:"namespace,omitempty"`
+		SelfLink                   string               `json:"selfLink,omitempty"`
+		UID                        UID                  `json:"uid,omitempty"`
+		ResourceVersion            string               `json:"resourceVersion,omitempty"`
+		Generation                 int64                `json:"generation,omitempty"`
+		CreationTimestamp          *Time                `json:"creationTimestamp,omitempty"`
+		DeletionTimestamp          *Time                `json:"deletionTimestamp,omitempty"`
+		DeletionGracePeriodSeconds *int64               `json:"deletionGracePeriodSeconds,omitempty"`
+		Labels                     map[string]string    `json:"labels,omitempty"`
+		Annotations                map[string]string    `json:"annotations,omitempty"`
+		OwnerReferences            []OwnerReference     `json:"ownerReferences,omitempty"`
+		Finalizers                 []string             `json:"finalizers,omitempty"`
+		ClusterName                string               `json:"clusterName,omitempty"`
+		ManagedFields              []ManagedFieldsEntry `json:"managedFields,omitempty"`
+	}
+
+	// OwnerReference contains enough information to let you identify an owning
+	// object. An owning object must be in the same namespace as the dependent,
+	// or be cluster-scoped, so there is no namespace field.
+	OwnerReference struct {
+		// API version of the referent.
+		APIVersion string `json:"apiVersion"`
+		// Kind of the referent.
+		Kind string `json:"kind"`
+		// Name of the referent.
+		Name string `json:"name"`
+		// UID of the referent.
+		UID UID `json:"uid"`
+		// If true, this reference points to the managing controller.
+		Controller *bool `json:"controller,omitempty"`
+		// If true, AND if the owner has the "foregroundDeletion" finalizer, then
+		// the owner cannot be deleted from the key-value store until this
+		// reference is removed.
+		BlockOwnerDeletion *bool `json:"blockOwnerDeletion,omitempty"`
+	}
+
+	// ManagedFieldsEntry is a workflow-id, a FieldSet and the group version of
+	// the resource that the fieldset applies to.
+	ManagedFieldsEntry struct {
+		// Manager is an identifier of the workflow managing these fields.
+		Manager string `json:"manager,omitempty"`
+		// Operation is the type of operation which lead to this entry: Apply or Update.
+		Operation string `json:"operation,omitempty"`
+		// APIVersion defines the version of this resource that this field set applies to.
+		APIVersion string `json:"apiVersion,omitempty"`
+		// Time is the timestamp of when the entry was last changed.
+		Time *Time `json:"time,omitempty"`
+		// FieldsType is the discriminator for the different fields format and version.
+		FieldsType string `json:"fieldsType,omitempty"`
+		// FieldsV1 holds the first JSON version format as described in the "FieldsV1" type.
+		FieldsV1 json.RawMessage `json:"fieldsV1,omitempty"`
+		// Subresource is the name of the subresource used to update that object.
+		Subresource string `json:"subresource,omitempty"`
 	}
 
 	ListMeta struct {
@@ -64,15 +130,60 @@ type (
 		// map is equivalent to an element of matchExpressions, whose key field is "key", the
 		// operator is "In", and the values array contains only "value". The requirements are ANDed.
 		MatchLabels map[string]string `json:"matchLabels,omitempty" protobuf:"bytes,1,rep,name=matchLabels"`
+		// matchExpressions is a list of label selector requirements. The requirements are ANDed.
+		MatchExpressions []LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+	}
+
+	// LabelSelectorRequirement is a selector that contains values, a key, and an operator that
+	// relates the key and values.
+	LabelSelectorRequirement struct {
+		// key is the label key that the selector applies to.
+		Key string `json:"key"`
+		// operator represents a key's relationship to a set of values.
+		Operator LabelSelectorOperator `json:"operator"`
+		// values is an array of string values. If the operator is In or NotIn,
+		// the values array must be non-empty. If the operator is Exists or DoesNotExist,
+		// the values array must be empty.
+		Values []string `json:"values,omitempty"`
 	}
 
+	// LabelSelectorOperator is the set of operators that can be used in a selector requirement.
+	LabelSelectorOperator string
+
 	FieldSelector map[string]string
 
+	// DeleteOptions may be provided when deleting an API object.
+	DeleteOptions struct {
+		TypeMeta `json:",inline"`
+		// The duration in seconds before the object should be deleted. Value must be non-negative integer.
+		// The value zero indicates delete immediately. If this value is nil, the default grace period for the
+		// specified type will be used.
+		GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+		// Must be fulfilled before a deletion is carried out. If not possible, a 409 Conflict status will be
+		// returned.
+		Preconditions *Preconditions `json:"preconditions,omitempty"`
+		// Whether and how garbage collection will be performed.
+		PropagationPolicy *DeletionPropagation `json:"propagationPolicy,omitempty"`
+	}
+
+	// Preconditions must be fulfilled before an operation (update, delete, etc.) is carried out.
+	Preconditions struct {
+		// Specifies the target UID.
+		UID *UID `json:"uid,omitempty"`
+	}
+
 	Object interface {
 		GetKind() string
+		GetAPIVersion() string
+		GetName() string
+		GetUID() UID
 		GetAnnotations() map[string]string
 		GetLabels() map[string]string
 		SetLabels(labels map[string]string)
+		GetOwnerReferences() []OwnerReference
+		SetOwnerReferences(references []OwnerReference)
+		GetFinalizers() []string
+		SetFinalizers(finalizers []string)
 	}
 
 	NamespacedObject interface {
@@ -89,10 +200,38 @@ func (t *TypeMeta) GetKind() string {
 	return t.Kind
 }
 
+func (t *TypeMeta) SetKind(kind string) {
+	t.Kind = kind
+}
+
+func (t *TypeMeta) GetAPIVersion() string {
+	return t.APIVersion
+}
+
+func (t *TypeMeta) SetAPIVersion(version string) {
+	t.APIVersion = version
+}
+
+func (o *ObjectMeta) GetName() string {
+	return o.Name
+}
+
+func (o *ObjectMeta) GetUID() UID {
+	return o.UID
+}
+
 func (o *ObjectMeta) GetNamespace() string {
 	return o.Namespace
 }
 
+func (o *ObjectMeta) SetNamespace(namespace string) {
+	o.Namespace = namespace
+}
+
+func (o *ObjectMeta) GetResourceVersion() string {
+	return o.ResourceVersion
+}
+
 func (o *ObjectMeta) GetAnnotations() map[string]string {
 	return o.Annotations
 }
@@ -105,6 +244,22 @@ func (o *ObjectMeta) SetLabels(labels map[string]string) {
 	o.Labels = labels
 }
 
+func (o *ObjectMeta) GetOwnerReferences() []OwnerReference {
+	return o.OwnerReferences
+}
+
+func (o *ObjectMeta) SetOwnerReferences(references []OwnerReference) {
+	o.OwnerReferences = references
+}
+
+func (o *ObjectMeta) GetFinalizers() []string {
+	return o.Finalizers
+}
+
+func (o *ObjectMeta) SetFinalizers(finalizers []string) {
+	o.Finalizers = finalizers
+}
+
 // NewTypeMeta creates a new TypeMeta and initializes the given kind & apiVersion
 func NewTypeMeta(kind, apiVersion string) TypeMeta {
 	return TypeMeta{
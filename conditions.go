@@ -0,0 +1,177 @@
+package client
+
+import "github.com/pkg/errors"
+
+// Conditions for use with WaitFor.
+
+// PodPhaseCondition is satisfied once the Pod reaches the given phase. It
+// fails if the Pod terminates in a different phase.
+func PodPhaseCondition(phase PodPhase) ConditionFunc {
+	return func(obj Object) (bool, error) {
+		if obj == nil {
+			return false, nil
+		}
+		pod, ok := obj.(*Pod)
+		if !ok {
+			return false, errors.Errorf("expected a Pod, got %T", obj)
+		}
+		if pod.Status == nil {
+			return false, nil
+		}
+		if pod.Status.Phase == phase {
+			return true, nil
+		}
+		if pod.Status.Phase == PodSucceeded || pod.Status.Phase == PodFailed {
+			return false, errors.Errorf("pod %q terminated in phase %s", pod.Name, pod.Status.Phase)
+		}
+		return false, nil
+	}
+}
+
+// PodReadyCondition is satisfied once the Pod has a Ready condition with
+// status True. It fails if the Pod terminates first.
+func PodReadyCondition() ConditionFunc {
+	return func(obj Object) (bool, error) {
+		if obj == nil {
+			return false, nil
+		}
+		pod, ok := obj.(*Pod)
+		if !ok {
+			return false, errors.Errorf("expected a Pod, got %T", obj)
+		}
+		if pod.Status == nil {
+			return false, nil
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == PodReady && c.Status == ConditionTrue {
+				return true, nil
+			}
+		}
+		if pod.Status.Phase == PodSucceeded || pod.Status.Phase == PodFailed {
+			return false, errors.Errorf("pod %q terminated in phase %s", pod.Name, pod.Status.Phase)
+		}
+		return false, nil
+	}
+}
+
+// JobCompleteCondition is satisfied once the Job has completed successfully.
+// It fails if the Job fails.
+func JobCompleteCondition() ConditionFunc {
+	return func(obj Object) (bool, error) {
+		if obj == nil {
+			return false, nil
+		}
+		job, ok := obj.(*Job)
+		if !ok {
+			return false, errors.Errorf("expected a Job, got %T", obj)
+		}
+		if c := job.condition(JobFailed); c != nil {
+			return false, errors.Errorf("job %q failed: %s", job.Name, c.Message)
+		}
+		return job.condition(JobComplete) != nil, nil
+	}
+}
+
+// JobFailedCondition is satisfied once the Job has failed. It fails if the
+// Job completes successfully.
+func JobFailedCondition() ConditionFunc {
+	return func(obj Object) (bool, error) {
+		if obj == nil {
+			return false, nil
+		}
+		job, ok := obj.(*Job)
+		if !ok {
+			return false, errors.Errorf("expected a Job, got %T", obj)
+		}
+		if job.condition(JobComplete) != nil {
+			return false, errors.Errorf("job %q completed", job.Name)
+		}
+		return job.condition(JobFailed) != nil, nil
+	}
+}
+
+// DaemonSetScheduledCondition is satisfied once the DaemonSet's pods are
+// scheduled to every node that should run them and to no other node.
+func DaemonSetScheduledCondition() ConditionFunc {
+	return func(obj Object) (bool, error) {
+		if obj == nil {
+			return false, nil
+		}
+		ds, ok := obj.(*DaemonSet)
+		if !ok {
+			return false, errors.Errorf("expected a DaemonSet, got %T", obj)
+		}
+		s := ds.Status
+		if s == nil || s.ObservedGeneration < ds.Generation {
+			return false, nil
+		}
+		return s.CurrentNumberScheduled == s.DesiredNumberScheduled && s.NumberMisscheduled == 0, nil
+	}
+}
+
+// DeletedCondition is satisfied once the object no longer exists.
+func DeletedCondition() ConditionFunc {
+	return func(obj Object) (bool, error) {
+		return obj == nil, nil
+	}
+}
+
+// condition returns the job condition of the given type if its status is True.
+func (j *Job) condition(t string) *JobCondition {
+	if j.Status == nil {
+		return nil
+	}
+	for i := range j.Status.Conditions {
+		c := &j.Status.Conditions[i]
+		if c.Type == t && c.Status == string(ConditionTrue) {
+			return c
+		}
+	}
+	return nil
+}
+
+// PersistentVolumeClaimBoundCondition is satisfied once the claim is bound
+// to a volume. It fails if the claim loses its volume.
+func PersistentVolumeClaimBoundCondition() ConditionFunc {
+	return func(obj Object) (bool, error) {
+		if obj == nil {
+			return false, nil
+		}
+		claim, ok := obj.(*PersistentVolumeClaim)
+		if !ok {
+			return false, errors.Errorf("expected a PersistentVolumeClaim, got %T", obj)
+		}
+		if claim.Status == nil {
+			return false, nil
+		}
+		switch claim.Status.Phase {
+		case ClaimBound:
+			return true, nil
+		case ClaimLost:
+			return false, errors.Errorf("persistent volume claim %q lost its volume", claim.Name)
+		}
+		return false, nil
+	}
+}
+
+// CustomResourceDefinitionEstablishedCondition is satisfied once the API
+// server serves the custom resources. It fails if their names are not
+// accepted, such as when they conflict with another resource.
+func CustomResourceDefinitionEstablishedCondition() ConditionFunc {
+	return func(obj Object) (bool, error) {
+		if obj == nil {
+			return false, nil
+		}
+		crd, ok := obj.(*CustomResourceDefinition)
+		if !ok {
+			return false, errors.Errorf("expected a CustomResourceDefinition, got %T", obj)
+		}
+		if c := crd.GetCondition(CustomResourceDefinitionEstablished); c != nil && c.Status == ConditionTrue {
+			return true, nil
+		}
+		if c := crd.GetCondition(CustomResourceDefinitionNamesAccepted); c != nil && c.Status == ConditionFalse {
+			return false, errors.Errorf("names of custom resource definition %q were not accepted: %s", crd.Name, c.Message)
+		}
+		return false, nil
+	}
+}
@@ -1,26 +1,15 @@
 package client
 
 type (
-	ConfigMapInterface interface {
-		CreateConfigMap(namespace string, item *ConfigMap) (*ConfigMap, error)
-		GetConfigMap(namespace, name string) (result *ConfigMap, err error)
-		ListConfigMaps(namespace string, opts *ListOptions) (*ConfigMapList, error)
-		WatchConfigMaps(namespace string, opts *WatchOptions, events chan ConfigMapWatchEvent) error
-		DeleteConfigMap(namespace, name string) error
-		UpdateConfigMap(namespace string, item *ConfigMap) (*ConfigMap, error)
-	}
-
-	ConfigMapWatchEvent interface {
-		Type() WatchEventType
-		Object() (*ConfigMap, error)
-	}
-
 	ConfigMapType string
 
 	ConfigMap struct {
 		TypeMeta   `json:",inline"`
 		ObjectMeta `json:"metadata,omitempty"`
 		Data       map[string]string `json:"data,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	ConfigMapList struct {
@@ -39,3 +28,17 @@ func NewConfigMap(namespace, name string) *ConfigMap {
 		Data:       make(map[string]string),
 	}
 }
+
+// UnmarshalJSON decodes the ConfigMap, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (c *ConfigMap) UnmarshalJSON(data []byte) error {
+	type alias ConfigMap
+	return unmarshalKeepingUnknown(data, (*alias)(c), &c.raw)
+}
+
+// MarshalJSON encodes the ConfigMap, including any fields it was decoded with
+// that this package does not model.
+func (c ConfigMap) MarshalJSON() ([]byte, error) {
+	type alias ConfigMap
+	return marshalKeepingUnknown(alias(c), c.raw)
+}
@@ -0,0 +1,112 @@
+package client
+
+const (
+	// AllowConcurrent allows CronJobs to run concurrently.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+	// ForbidConcurrent forbids concurrent runs, skipping the next run if the
+	// previous hasn't finished yet.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+	// ReplaceConcurrent cancels the currently running job and replaces it
+	// with a new one.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
+type (
+	// CronJob represents the configuration of a single cron job.
+	CronJob struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Specification of the desired behavior of a cron job, including the schedule.
+		Spec *CronJobSpec `json:"spec,omitempty"`
+
+		// Current status of a cron job.
+		Status *CronJobStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// CronJobSpec describes how the job execution will look like and when it
+	// will actually run.
+	CronJobSpec struct {
+		// The schedule in Cron format, see https://en.wikipedia.org/wiki/Cron.
+		Schedule string `json:"schedule"`
+		// The time zone name for the given schedule. If not specified, this
+		// will default to the time zone of the kube-controller-manager process.
+		TimeZone *string `json:"timeZone,omitempty"`
+		// Optional deadline in seconds for starting the job if it misses
+		// scheduled time for any reason. Missed jobs executions will be
+		// counted as failed ones.
+		StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+		// Specifies how to treat concurrent executions of a Job.
+		// Defaults to Allow.
+		ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+		// This flag tells the controller to suspend subsequent executions, it
+		// does not apply to already started executions. Defaults to false.
+		Suspend *bool `json:"suspend,omitempty"`
+		// Specifies the job that will be created when executing a CronJob.
+		JobTemplate JobTemplateSpec `json:"jobTemplate"`
+		// The number of successful finished jobs to retain. Defaults to 3.
+		SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+		// The number of failed finished jobs to retain. Defaults to 1.
+		FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+	}
+
+	// JobTemplateSpec describes the data a Job should have when created from a template
+	JobTemplateSpec struct {
+		// Standard object's metadata of the jobs created from this template.
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Specification of the desired behavior of the job.
+		Spec *JobSpec `json:"spec,omitempty"`
+	}
+
+	// CronJobStatus represents the current state of a cron job.
+	CronJobStatus struct {
+		// A list of pointers to currently running jobs.
+		Active []ObjectReference `json:"active,omitempty"`
+		// Information when was the last time the job was successfully scheduled.
+		LastScheduleTime *Time `json:"lastScheduleTime,omitempty"`
+		// Information when was the last time the job successfully completed.
+		LastSuccessfulTime *Time `json:"lastSuccessfulTime,omitempty"`
+	}
+
+	CronJobList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is the list of cron jobs.
+		Items []CronJob `json:"items"`
+	}
+
+	// ConcurrencyPolicy describes how the job will be handled.
+	ConcurrencyPolicy string
+)
+
+// NewCronJob creates a new CronJob struct
+func NewCronJob(namespace, name string) *CronJob {
+	return &CronJob{
+		TypeMeta:   NewTypeMeta("CronJob", "batch/v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+		Spec: &CronJobSpec{
+			JobTemplate: JobTemplateSpec{
+				Spec: &JobSpec{},
+			},
+		},
+	}
+}
+
+// UnmarshalJSON decodes the CronJob, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (c *CronJob) UnmarshalJSON(data []byte) error {
+	type alias CronJob
+	return unmarshalKeepingUnknown(data, (*alias)(c), &c.raw)
+}
+
+// MarshalJSON encodes the CronJob, including any fields it was decoded with
+// that this package does not model.
+func (c CronJob) MarshalJSON() ([]byte, error) {
+	type alias CronJob
+	return marshalKeepingUnknown(alias(c), c.raw)
+}
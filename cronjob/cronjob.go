@@ -0,0 +1,198 @@
+// Package cronjob provides helpers for running CronJobs by hand and
+// inspecting the Jobs they created.
+package cronjob
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+const (
+	// InstantiateAnnotation is set to "manual" on Jobs created by Trigger,
+	// like kubectl create job --from does.
+	InstantiateAnnotation = "cronjob.kubernetes.io/instantiate"
+	// ScheduledTimestampAnnotation is set by the CronJob controller on the
+	// Jobs it creates to the time they were scheduled for, in RFC3339.
+	ScheduledTimestampAnnotation = "batch.kubernetes.io/cronjob-scheduled-timestamp"
+
+	// Running means the Job has neither completed nor failed yet.
+	Running Outcome = "Running"
+	// Succeeded means the Job has completed successfully.
+	Succeeded Outcome = "Succeeded"
+	// Failed means the Job has failed.
+	Failed Outcome = "Failed"
+)
+
+type (
+	// Client is the subset of the Kubernetes client used to manage CronJobs.
+	Client interface {
+		k8s.CronJobInterface
+		k8s.JobInterface
+	}
+
+	// Run is a single Job created from a CronJob.
+	Run struct {
+		// Job is the Job of this run.
+		Job *k8s.Job
+		// ScheduledTime is the time the run was scheduled for. For manual runs
+		// it is the time the Job was created.
+		ScheduledTime time.Time
+		// Manual is true if the run was created by hand rather than by the
+		// CronJob controller.
+		Manual bool
+		// Outcome is the outcome of the Job so far.
+		Outcome Outcome
+		// Status is the status of the Job.
+		Status *k8s.JobStatus
+	}
+
+	// Outcome is the outcome of a Job.
+	Outcome string
+)
+
+// NewJob returns a Job built from the CronJob's job template, controlled by
+// the CronJob and annotated as a manual run. If name is empty the Job gets a
+// generated name prefixed by the CronJob's name. The CronJob is not modified.
+func NewJob(cj *k8s.CronJob, name string) (*k8s.Job, error) {
+	if cj.Spec == nil || cj.Spec.JobTemplate.Spec == nil {
+		return nil, errors.Errorf("cronjob %q has no job template", cj.Name)
+	}
+	// objects that were not fetched on their own may lack these, which the
+	// owner reference needs
+	owner := *cj
+	if owner.Kind == "" {
+		owner.Kind = "CronJob"
+	}
+	if owner.APIVersion == "" {
+		owner.APIVersion = k8s.PreferredGroupVersion("CronJob")
+	}
+
+	template := cj.Spec.JobTemplate
+	job := k8s.NewJob(cj.Namespace, name)
+	if name == "" {
+		job.GenerateName = cj.Name + "-manual-"
+	}
+	job.Labels = make(map[string]string, len(template.Labels))
+	for k, v := range template.Labels {
+		job.Labels[k] = v
+	}
+	job.Annotations = make(map[string]string, len(template.Annotations)+1)
+	for k, v := range template.Annotations {
+		job.Annotations[k] = v
+	}
+	job.Annotations[InstantiateAnnotation] = "manual"
+	spec := *template.Spec
+	job.Spec = &spec
+
+	if err := k8s.SetControllerReference(&owner, job); err != nil {
+		return nil, errors.Wrap(err, "failed to set owner reference")
+	}
+	return job, nil
+}
+
+// Trigger runs the CronJob now by creating a Job from its job template,
+// regardless of its schedule or whether it is suspended. If jobName is
+// empty the Job gets a generated name.
+func Trigger(c Client, namespace, name, jobName string) (*k8s.Job, error) {
+	cj, err := c.GetCronJob(namespace, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cronjob")
+	}
+	job, err := NewJob(cj, jobName)
+	if err != nil {
+		return nil, err
+	}
+	out, err := c.CreateJob(namespace, job)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create job")
+	}
+	return out, nil
+}
+
+// History returns the Jobs controlled by the CronJob, ordered from the
+// oldest to the newest scheduled time. It includes manual runs. The CronJob
+// controller deletes finished Jobs beyond the history limits, so older runs
+// are not available.
+func History(c Client, namespace, name string) ([]Run, error) {
+	cj, err := c.GetCronJob(namespace, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get cronjob")
+	}
+
+	var opts *k8s.ListOptions
+	if cj.Spec != nil && len(cj.Spec.JobTemplate.Labels) > 0 {
+		opts = &k8s.ListOptions{
+			LabelSelector: k8s.LabelSelector{MatchLabels: cj.Spec.JobTemplate.Labels},
+		}
+	}
+	list, err := c.ListJobs(namespace, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list jobs")
+	}
+
+	var runs []Run
+	for i := range list.Items {
+		job := &list.Items[i]
+		if ref := k8s.GetControllerOf(job); ref == nil || ref.UID != cj.UID {
+			continue
+		}
+		runs = append(runs, Run{
+			Job:           job,
+			ScheduledTime: scheduledTime(cj, job),
+			Manual:        job.Annotations[InstantiateAnnotation] == "manual",
+			Outcome:       outcome(job),
+			Status:        job.Status,
+		})
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		if !runs[i].ScheduledTime.Equal(runs[j].ScheduledTime) {
+			return runs[i].ScheduledTime.Before(runs[j].ScheduledTime)
+		}
+		return runs[i].Job.Name < runs[j].Job.Name
+	})
+	return runs, nil
+}
+
+// scheduledTime returns the time the Job was scheduled for. Newer
+// controllers record it in an annotation; older ones name the Job after it,
+// in minutes since the epoch. Manual runs use their creation time.
+func scheduledTime(cj *k8s.CronJob, job *k8s.Job) time.Time {
+	if value, ok := job.Annotations[ScheduledTimestampAnnotation]; ok {
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t
+		}
+	}
+	if job.Annotations[InstantiateAnnotation] != "manual" && strings.HasPrefix(job.Name, cj.Name+"-") {
+		if minutes, err := strconv.ParseInt(strings.TrimPrefix(job.Name, cj.Name+"-"), 10, 64); err == nil {
+			return time.Unix(minutes*60, 0).UTC()
+		}
+	}
+	if job.CreationTimestamp == nil {
+		return time.Time{}
+	}
+	return job.CreationTimestamp.Time
+}
+
+func outcome(job *k8s.Job) Outcome {
+	if job.Status == nil {
+		return Running
+	}
+	for _, c := range job.Status.Conditions {
+		if c.Status != string(k8s.ConditionTrue) {
+			continue
+		}
+		switch c.Type {
+		case k8s.JobComplete:
+			return Succeeded
+		case k8s.JobFailed:
+			return Failed
+		}
+	}
+	return Running
+}
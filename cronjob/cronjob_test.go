@@ -0,0 +1,103 @@
+package cronjob
+
+import (
+	"testing"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCronJob(t *testing.T, c *fake.Client) *k8s.CronJob {
+	cj := k8s.NewCronJob("default", "backup")
+	cj.Spec.Schedule = "0 * * * *"
+	cj.Spec.JobTemplate.Labels = map[string]string{"app": "backup"}
+	cj.Spec.JobTemplate.Spec.Template.Spec = &k8s.PodSpec{RestartPolicy: k8s.RestartPolicyNever}
+	cj, err := c.CreateCronJob("default", cj)
+	require.Nil(t, err)
+	return cj
+}
+
+// newScheduledJob creates a Job as the CronJob controller would.
+func newScheduledJob(t *testing.T, c *fake.Client, cj *k8s.CronJob, name string, annotations map[string]string, condition string) {
+	job := k8s.NewJob("default", name)
+	job.Labels = map[string]string{"app": "backup"}
+	job.Annotations = annotations
+	require.Nil(t, k8s.SetControllerReference(cj, job))
+	if condition != "" {
+		job.Status = &k8s.JobStatus{Conditions: []k8s.JobCondition{{Type: condition, Status: string(k8s.ConditionTrue)}}}
+	}
+	_, err := c.CreateJob("default", job)
+	require.Nil(t, err)
+}
+
+func TestTrigger(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	cj := newCronJob(t, c)
+
+	job, err := Trigger(c, "default", "backup", "")
+	require.Nil(t, err)
+	assert.Contains(t, job.Name, "backup-manual-")
+	assert.Equal(t, "manual", job.Annotations[InstantiateAnnotation])
+	assert.Equal(t, "backup", job.Labels["app"])
+	assert.Equal(t, k8s.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+
+	ref := k8s.GetControllerOf(job)
+	require.NotNil(t, ref)
+	assert.Equal(t, cj.UID, ref.UID)
+	assert.Equal(t, "CronJob", ref.Kind)
+
+	job, err = Trigger(c, "default", "backup", "backup-now")
+	require.Nil(t, err)
+	assert.Equal(t, "backup-now", job.Name)
+
+	_, err = Trigger(c, "default", "missing", "")
+	assert.True(t, k8s.IsNotFoundError(err))
+}
+
+func TestNewJob(t *testing.T) {
+	cj := k8s.NewCronJob("default", "backup")
+	cj.UID = "cronjob-uid"
+	cj.TypeMeta = k8s.TypeMeta{}
+
+	job, err := NewJob(cj, "backup-now")
+	require.Nil(t, err)
+	ref := k8s.GetControllerOf(job)
+	require.NotNil(t, ref)
+	assert.Equal(t, "CronJob", ref.Kind)
+	assert.Equal(t, "batch/v1", ref.APIVersion)
+	assert.Equal(t, k8s.TypeMeta{}, cj.TypeMeta, "the CronJob should not be modified")
+}
+
+func TestHistory(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	cj := newCronJob(t, c)
+	other := k8s.NewCronJob("default", "other")
+	other, err = c.CreateCronJob("default", other)
+	require.Nil(t, err)
+
+	newScheduledJob(t, c, cj, "backup-28000060", nil, k8s.JobComplete)
+	newScheduledJob(t, c, cj, "backup-b8xkq", map[string]string{ScheduledTimestampAnnotation: "2023-03-30T02:00:00Z"}, "")
+	newScheduledJob(t, c, cj, "backup-28000000", nil, k8s.JobFailed)
+	newScheduledJob(t, c, other, "other-28000000", nil, "")
+	_, err = Trigger(c, "default", "backup", "backup-manual")
+	require.Nil(t, err)
+
+	runs, err := History(c, "default", "backup")
+	require.Nil(t, err)
+	require.Len(t, runs, 4)
+
+	assert.Equal(t, "backup-28000000", runs[0].Job.Name)
+	assert.Equal(t, time.Unix(28000000*60, 0).UTC(), runs[0].ScheduledTime)
+	assert.Equal(t, Failed, runs[0].Outcome)
+	assert.Equal(t, "backup-28000060", runs[1].Job.Name)
+	assert.Equal(t, Succeeded, runs[1].Outcome)
+	assert.Equal(t, "backup-b8xkq", runs[2].Job.Name)
+	assert.Equal(t, Running, runs[2].Outcome)
+	assert.Equal(t, "backup-manual", runs[3].Job.Name)
+	assert.True(t, runs[3].Manual)
+}
@@ -0,0 +1,321 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const (
+	// ClusterScoped is the scope of custom resources that are not in a namespace.
+	ClusterScoped ResourceScope = "Cluster"
+	// NamespaceScoped is the scope of custom resources that are in a namespace.
+	NamespaceScoped ResourceScope = "Namespaced"
+
+	// CustomResourceDefinitionEstablished means the API server has started
+	// serving the custom resources.
+	CustomResourceDefinitionEstablished CustomResourceDefinitionConditionType = "Established"
+	// CustomResourceDefinitionNamesAccepted means the names chosen for the
+	// custom resources do not conflict with those of other resources.
+	CustomResourceDefinitionNamesAccepted CustomResourceDefinitionConditionType = "NamesAccepted"
+	// CustomResourceDefinitionTerminating means the CustomResourceDefinition
+	// is being deleted.
+	CustomResourceDefinitionTerminating CustomResourceDefinitionConditionType = "Terminating"
+)
+
+type (
+	// CustomResourceDefinition represents a resource that should be exposed
+	// on the API server. Its name MUST be in the format <.spec.name>.<.spec.group>.
+	CustomResourceDefinition struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec describes how the user wants the resources to appear.
+		Spec *CustomResourceDefinitionSpec `json:"spec,omitempty"`
+		// Status indicates the actual state of the CustomResourceDefinition.
+		Status *CustomResourceDefinitionStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// CustomResourceDefinitionList is a list of CustomResourceDefinition objects.
+	CustomResourceDefinitionList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		Items []CustomResourceDefinition `json:"items"`
+	}
+
+	// CustomResourceDefinitionSpec describes how a user wants their resource
+	// to appear.
+	CustomResourceDefinitionSpec struct {
+		// Group is the API group of the defined custom resource, such as
+		// "stable.example.com". The custom resources are served under
+		// `/apis/<group>/...`.
+		Group string `json:"group"`
+		// Names specify the resource and kind names for the custom resource.
+		Names CustomResourceDefinitionNames `json:"names"`
+		// Scope indicates whether the defined custom resource is cluster or
+		// namespace scoped.
+		Scope ResourceScope `json:"scope"`
+		// Versions is the list of all API versions of the defined custom
+		// resource. Exactly one version must be the storage version.
+		Versions []CustomResourceDefinitionVersion `json:"versions"`
+		// PreserveUnknownFields indicates that object fields which are not
+		// specified in the OpenAPI schema should be preserved when persisting
+		// to storage.
+		PreserveUnknownFields bool `json:"preserveUnknownFields,omitempty"`
+	}
+
+	// CustomResourceDefinitionNames indicates the names to serve this
+	// CustomResourceDefinition.
+	CustomResourceDefinitionNames struct {
+		// Plural is the plural name of the resource to serve, such as
+		// "crontabs". It must be all lowercase.
+		Plural string `json:"plural"`
+		// Singular is the singular name of the resource. It must be all
+		// lowercase. Defaults to the lowercased kind.
+		Singular string `json:"singular,omitempty"`
+		// ShortNames are short names for the resource, exposed in API
+		// discovery documents, such as "ct".
+		ShortNames []string `json:"shortNames,omitempty"`
+		// Kind is the serialized kind of the resource, such as "CronTab".
+		Kind string `json:"kind"`
+		// ListKind is the serialized kind of the list for this resource.
+		// Defaults to "<kind>List".
+		ListKind string `json:"listKind,omitempty"`
+		// Categories is a list of grouped resources this custom resource
+		// belongs to, such as "all".
+		Categories []string `json:"categories,omitempty"`
+	}
+
+	// CustomResourceDefinitionVersion describes a version for a CRD.
+	CustomResourceDefinitionVersion struct {
+		// Name is the version name, such as "v1" or "v2beta1".
+		Name string `json:"name"`
+		// Served is a flag enabling/disabling this version from being served
+		// via REST APIs.
+		Served bool `json:"served"`
+		// Storage indicates this version should be used when persisting
+		// custom resources to storage. There must be exactly one version
+		// with storage=true.
+		Storage bool `json:"storage"`
+		// Deprecated indicates this version of the custom resource API is
+		// deprecated.
+		Deprecated bool `json:"deprecated,omitempty"`
+		// DeprecationWarning overrides the default warning returned to API
+		// clients.
+		DeprecationWarning *string `json:"deprecationWarning,omitempty"`
+		// Schema describes the schema used for validation, pruning, and
+		// defaulting of this version of the custom resource.
+		Schema *CustomResourceValidation `json:"schema,omitempty"`
+		// Subresources specify what subresources this version of the defined
+		// custom resource have.
+		Subresources *CustomResourceSubresources `json:"subresources,omitempty"`
+		// AdditionalPrinterColumns specifies additional columns returned in
+		// Table output.
+		AdditionalPrinterColumns []CustomResourceColumnDefinition `json:"additionalPrinterColumns,omitempty"`
+	}
+
+	// CustomResourceValidation is a list of validation methods for
+	// CustomResources.
+	CustomResourceValidation struct {
+		// OpenAPIV3Schema is the OpenAPI v3 schema to use for validation and pruning.
+		OpenAPIV3Schema *JSONSchemaProps `json:"openAPIV3Schema,omitempty"`
+	}
+
+	// JSONSchemaProps is a JSON-Schema following Specification Draft 4
+	// (http://json-schema.org/). Only the commonly used properties are
+	// modeled; others are kept as they were decoded.
+	JSONSchemaProps struct {
+		Description string                     `json:"description,omitempty"`
+		Type        string                     `json:"type,omitempty"`
+		Format      string                     `json:"format,omitempty"`
+		Title       string                     `json:"title,omitempty"`
+		Default     json.RawMessage            `json:"default,omitempty"`
+		Maximum     *float64                   `json:"maximum,omitempty"`
+		Minimum     *float64                   `json:"minimum,omitempty"`
+		MaxLength   *int64                     `json:"maxLength,omitempty"`
+		MinLength   *int64                     `json:"minLength,omitempty"`
+		Pattern     string                     `json:"pattern,omitempty"`
+		MaxItems    *int64                     `json:"maxItems,omitempty"`
+		MinItems    *int64                     `json:"minItems,omitempty"`
+		Enum        []json.RawMessage          `json:"enum,omitempty"`
+		Required    []string                   `json:"required,omitempty"`
+		Items       *JSONSchemaProps           `json:"items,omitempty"`
+		Properties  map[string]JSONSchemaProps `json:"properties,omitempty"`
+		Nullable    bool                       `json:"nullable,omitempty"`
+
+		// XPreserveUnknownFields stops the API server decoding step from
+		// pruning fields which are not specified in the validation schema.
+		XPreserveUnknownFields *bool `json:"x-kubernetes-preserve-unknown-fields,omitempty"`
+		// XEmbeddedResource defines that the value is an embedded Kubernetes
+		// runtime.Object, with TypeMeta and ObjectMeta.
+		XEmbeddedResource bool `json:"x-kubernetes-embedded-resource,omitempty"`
+		// XIntOrString specifies that this value is either an integer or a string.
+		XIntOrString bool `json:"x-kubernetes-int-or-string,omitempty"`
+	}
+
+	// CustomResourceSubresources defines the status and scale subresources
+	// for CustomResources.
+	CustomResourceSubresources struct {
+		// Status indicates the custom resource should serve a `/status`
+		// subresource.
+		Status *CustomResourceSubresourceStatus `json:"status,omitempty"`
+		// Scale indicates the custom resource should serve a `/scale`
+		// subresource.
+		Scale *CustomResourceSubresourceScale `json:"scale,omitempty"`
+	}
+
+	// CustomResourceSubresourceStatus defines how to serve the status
+	// subresource for CustomResources. It has no fields.
+	CustomResourceSubresourceStatus struct{}
+
+	// CustomResourceSubresourceScale defines how to serve the scale
+	// subresource for CustomResources.
+	CustomResourceSubresourceScale struct {
+		// SpecReplicasPath defines the JSON path inside of a custom resource
+		// that corresponds to Scale `spec.replicas`, such as ".spec.replicas".
+		SpecReplicasPath string `json:"specReplicasPath"`
+		// StatusReplicasPath defines the JSON path inside of a custom resource
+		// that corresponds to Scale `status.replicas`.
+		StatusReplicasPath string `json:"statusReplicasPath"`
+		// LabelSelectorPath defines the JSON path inside of a custom resource
+		// that corresponds to Scale `status.selector`.
+		LabelSelectorPath *string `json:"labelSelectorPath,omitempty"`
+	}
+
+	// CustomResourceColumnDefinition specifies a column for server side
+	// printing.
+	CustomResourceColumnDefinition struct {
+		// Name is a human readable name for the column.
+		Name string `json:"name"`
+		// Type is an OpenAPI type definition for this column, such as "string".
+		Type string `json:"type"`
+		// Format is an optional OpenAPI type definition for this column.
+		Format string `json:"format,omitempty"`
+		// Description is a human readable description of this column.
+		Description string `json:"description,omitempty"`
+		// Priority is an integer defining the relative importance of this
+		// column compared to others. 0 is the highest priority.
+		Priority int32 `json:"priority,omitempty"`
+		// JSONPath is a simple JSON path which is evaluated against each
+		// custom resource to produce the value for this column.
+		JSONPath string `json:"jsonPath"`
+	}
+
+	// CustomResourceDefinitionStatus indicates the state of the
+	// CustomResourceDefinition.
+	CustomResourceDefinitionStatus struct {
+		// Conditions indicate state for particular aspects of a
+		// CustomResourceDefinition.
+		Conditions []CustomResourceDefinitionCondition `json:"conditions,omitempty"`
+		// AcceptedNames are the names that are actually being used to serve
+		// discovery. They may be different than the names in spec.
+		AcceptedNames CustomResourceDefinitionNames `json:"acceptedNames"`
+		// StoredVersions lists all versions of CustomResources that were ever
+		// persisted.
+		StoredVersions []string `json:"storedVersions,omitempty"`
+	}
+
+	// CustomResourceDefinitionCondition contains details for the current
+	// condition of this CustomResourceDefinition.
+	CustomResourceDefinitionCondition struct {
+		// Type is the type of the condition.
+		Type CustomResourceDefinitionConditionType `json:"type"`
+		// Status is the status of the condition. Can be True, False, Unknown.
+		Status ConditionStatus `json:"status"`
+		// Last time the condition transitioned from one status to another.
+		LastTransitionTime *Time `json:"lastTransitionTime,omitempty"`
+		// Unique, one-word, CamelCase reason for the condition's last transition.
+		Reason string `json:"reason,omitempty"`
+		// Human-readable message indicating details about last transition.
+		Message string `json:"message,omitempty"`
+	}
+
+	// ResourceScope is an enum defining the different scopes available to a
+	// custom resource.
+	ResourceScope string
+
+	// CustomResourceDefinitionConditionType is a valid value for
+	// CustomResourceDefinitionCondition.Type.
+	CustomResourceDefinitionConditionType string
+)
+
+// NewCustomResourceDefinition creates a new CustomResourceDefinition struct
+// for the resource with the given plural name in the API group. The name of
+// the CustomResourceDefinition is "<plural>.<group>".
+func NewCustomResourceDefinition(group, plural, kind string, scope ResourceScope) *CustomResourceDefinition {
+	return &CustomResourceDefinition{
+		TypeMeta:   NewTypeMeta("CustomResourceDefinition", "apiextensions.k8s.io/v1"),
+		ObjectMeta: NewObjectMeta("", plural+"."+group),
+		Spec: &CustomResourceDefinitionSpec{
+			Group: group,
+			Names: CustomResourceDefinitionNames{
+				Plural: plural,
+				Kind:   kind,
+			},
+			Scope: scope,
+		},
+	}
+}
+
+// GroupVersions returns the group versions the custom resources are served
+// at, with the storage version first.
+func (c *CustomResourceDefinition) GroupVersions() []string {
+	if c.Spec == nil {
+		return nil
+	}
+	var versions []string
+	for _, v := range c.Spec.Versions {
+		if !v.Served {
+			continue
+		}
+		gv := c.Spec.Group + "/" + v.Name
+		if v.Storage {
+			versions = append([]string{gv}, versions...)
+		} else {
+			versions = append(versions, gv)
+		}
+	}
+	return versions
+}
+
+// GetCondition returns the condition of the given type, or nil if the
+// CustomResourceDefinition does not have it.
+func (c *CustomResourceDefinition) GetCondition(t CustomResourceDefinitionConditionType) *CustomResourceDefinitionCondition {
+	if c.Status == nil {
+		return nil
+	}
+	for i := range c.Status.Conditions {
+		if c.Status.Conditions[i].Type == t {
+			return &c.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// WaitForCustomResourceDefinitionEstablished blocks until the API server
+// serves the custom resources, their names conflict with other resources,
+// or the context is done.
+func WaitForCustomResourceDefinitionEstablished(ctx context.Context, c CustomResourceDefinitionInterface, name string) (*CustomResourceDefinition, error) {
+	obj, err := WaitFor(ctx, CustomResourceDefinitionListWatcher(c, name), CustomResourceDefinitionEstablishedCondition())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*CustomResourceDefinition), nil
+}
+
+// UnmarshalJSON decodes the CustomResourceDefinition, keeping the fields this
+// package does not model so that they are sent back by MarshalJSON.
+func (c *CustomResourceDefinition) UnmarshalJSON(data []byte) error {
+	type alias CustomResourceDefinition
+	return unmarshalKeepingUnknown(data, (*alias)(c), &c.raw)
+}
+
+// MarshalJSON encodes the CustomResourceDefinition, including any fields it
+// was decoded with that this package does not model.
+func (c CustomResourceDefinition) MarshalJSON() ([]byte, error) {
+	type alias CustomResourceDefinition
+	return marshalKeepingUnknown(alias(c), c.raw)
+}
@@ -1,21 +1,6 @@
 package client
 
 type (
-	// DaemonSetInterface has methods to work with DaemonSet resources.
-	DaemonSetInterface interface {
-		CreateDaemonSet(namespace string, item *DaemonSet) (*DaemonSet, error)
-		GetDaemonSet(namespace, name string) (result *DaemonSet, err error)
-		ListDaemonSets(namespace string, opts *ListOptions) (*DaemonSetList, error)
-		WatchDaemonSets(namespace string, opts *WatchOptions, events chan DaemonSetWatchEvent) error
-		DeleteDaemonSet(namespace, name string) error
-		UpdateDaemonSet(namespace string, item *DaemonSet) (*DaemonSet, error)
-	}
-
-	DaemonSetWatchEvent interface {
-		Type() WatchEventType
-		Object() (*DaemonSet, error)
-	}
-
 	// DaemonSet represents the configuration of a daemon set.
 	DaemonSet struct {
 		TypeMeta   `json:",inline"`
@@ -26,6 +11,9 @@ type (
 
 		// Most recently observed status of the DaemonSet.
 		Status *DaemonSetStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// DaemonSetSpec is the specification of a daemon set.
@@ -50,6 +38,8 @@ type (
 		DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
 		// NumberReady is the number of nodes that should be running the daemon pod and have one or more of the daemon pod running and ready.
 		NumberReady int32 `json:"numberReady"`
+		// ObservedGeneration is the most recent generation observed by the daemon set controller.
+		ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	}
 
 	DaemonSetList struct {
@@ -69,3 +59,17 @@ func NewDaemonSet(namespace, name string) *DaemonSet {
 		Spec:       &DaemonSetSpec{},
 	}
 }
+
+// UnmarshalJSON decodes the DaemonSet, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (d *DaemonSet) UnmarshalJSON(data []byte) error {
+	type alias DaemonSet
+	return unmarshalKeepingUnknown(data, (*alias)(d), &d.raw)
+}
+
+// MarshalJSON encodes the DaemonSet, including any fields it was decoded with
+// that this package does not model.
+func (d DaemonSet) MarshalJSON() ([]byte, error) {
+	type alias DaemonSet
+	return marshalKeepingUnknown(alias(d), d.raw)
+}
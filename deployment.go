@@ -6,24 +6,25 @@ const (
 
 	// Replace the old RCs by new one using rolling update i.e gradually scale down the old RCs and scale up the new one.
 	RollingUpdateDeploymentStrategyType DeploymentStrategyType = "RollingUpdate"
+
+	// Available means the deployment is available, ie. at least the minimum available
+	// replicas required are up and running for at least minReadySeconds.
+	DeploymentAvailable DeploymentConditionType = "Available"
+	// Progressing means the deployment is progressing. Progress for a deployment is
+	// considered when a new replica set is created or adopted, and when new pods scale
+	// up or old pods scale down. Progress is not estimated for paused deployments or
+	// when progressDeadlineSeconds is not specified.
+	DeploymentProgressing DeploymentConditionType = "Progressing"
+	// ReplicaFailure is added in a deployment when one of its pods fails to be created
+	// or deleted.
+	DeploymentReplicaFailure DeploymentConditionType = "ReplicaFailure"
+
+	// TimedOutReason is the reason of the Progressing condition once the deployment
+	// has exceeded its progress deadline.
+	TimedOutReason = "ProgressDeadlineExceeded"
 )
 
 type (
-	// DeploymentInterface has methods to work with Deployment resources.
-	DeploymentInterface interface {
-		CreateDeployment(namespace string, item *Deployment) (*Deployment, error)
-		GetDeployment(namespace, name string) (result *Deployment, err error)
-		ListDeployments(namespace string, opts *ListOptions) (*DeploymentList, error)
-		WatchDeployments(namespace string, opts *WatchOptions, events chan DeploymentWatchEvent) error
-		DeleteDeployment(namespace, name string) error
-		UpdateDeployment(namespace string, item *Deployment) (*Deployment, error)
-	}
-
-	DeploymentWatchEvent interface {
-		Type() WatchEventType
-		Object() (*Deployment, error)
-	}
-
 	Deployment struct {
 		TypeMeta   `json:",inline"`
 		ObjectMeta `json:"metadata,omitempty"`
@@ -33,6 +34,9 @@ type (
 
 		// Most recently observed status of the Deployment.
 		Status *DeploymentStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// DeploymentSpec is the specification of the desired behavior of the Deployment.
@@ -65,6 +69,12 @@ type (
 		Paused bool `json:"paused,omitempty"`
 		// The config this deployment is rolling back to. Will be cleared after rollback is done.
 		RollbackTo *RollbackConfig `json:"rollbackTo,omitempty"`
+
+		// The maximum time in seconds for a deployment to make progress before it
+		// is considered to be failed. The deployment controller will continue to
+		// process failed deployments and a condition with a ProgressDeadlineExceeded
+		// reason will be surfaced in the deployment status.
+		ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
 	}
 
 	DeploymentStrategy struct {
@@ -136,8 +146,32 @@ type (
 		// Total number of available pods (ready for at least minReadySeconds) targeted by this deployment.
 		AvailableReplicas int `json:"availableReplicas,omitempty"`
 
+		// Total number of ready pods targeted by this deployment.
+		ReadyReplicas int `json:"readyReplicas,omitempty"`
+
 		// Total number of unavailable pods targeted by this deployment.
 		UnavailableReplicas int `json:"unavailableReplicas,omitempty"`
+
+		// Represents the latest available observations of a deployment's current state.
+		Conditions []DeploymentCondition `json:"conditions,omitempty"`
+	}
+
+	DeploymentConditionType string
+
+	// DeploymentCondition describes the state of a deployment at a certain point.
+	DeploymentCondition struct {
+		// Type of deployment condition.
+		Type DeploymentConditionType `json:"type"`
+		// Status of the condition, one of True, False, Unknown.
+		Status ConditionStatus `json:"status"`
+		// The last time this condition was updated.
+		LastUpdateTime Time `json:"lastUpdateTime,omitempty"`
+		// Last time the condition transitioned from one status to another.
+		LastTransitionTime Time `json:"lastTransitionTime,omitempty"`
+		// The reason for the condition's last transition.
+		Reason string `json:"reason,omitempty"`
+		// A human readable message indicating details about the transition.
+		Message string `json:"message,omitempty"`
 	}
 
 	DeploymentList struct {
@@ -165,3 +199,17 @@ func NewPodTemplateSpec(namespace, name string) *PodTemplateSpec {
 		Spec:       &PodSpec{},
 	}
 }
+
+// UnmarshalJSON decodes the Deployment, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (d *Deployment) UnmarshalJSON(data []byte) error {
+	type alias Deployment
+	return unmarshalKeepingUnknown(data, (*alias)(d), &d.raw)
+}
+
+// MarshalJSON encodes the Deployment, including any fields it was decoded with
+// that this package does not model.
+func (d Deployment) MarshalJSON() ([]byte, error) {
+	type alias Deployment
+	return marshalKeepingUnknown(alias(d), d.raw)
+}
@@ -0,0 +1,270 @@
+package client
+
+import (
+	"sort"
+	"strings"
+)
+
+type (
+	// DiscoveryInterface finds out which groups, versions and resources the
+	// API server supports.
+	DiscoveryInterface interface {
+		// ServerVersion returns the version of the API server.
+		ServerVersion() (*VersionInfo, error)
+		// ServerGroups returns the supported groups. The core group is
+		// included with an empty name.
+		ServerGroups() (*APIGroupList, error)
+		// ServerResourcesForGroupVersion returns the supported resources for
+		// a group version, such as "v1" or "apps/v1".
+		ServerResourcesForGroupVersion(groupVersion string) (*APIResourceList, error)
+		// RESTMapping returns the resource for a kind. The versions are tried
+		// in order, and the preferred version of the group is used if none
+		// are given.
+		RESTMapping(kind GroupKind, versions ...string) (*RESTMapping, error)
+	}
+
+	// GroupKind identifies a kind, such as "Deployment" in the "apps" group.
+	GroupKind struct {
+		Group string
+		Kind  string
+	}
+
+	// GroupVersionKind identifies a kind at a version of its group.
+	GroupVersionKind struct {
+		Group   string
+		Version string
+		Kind    string
+	}
+
+	// RESTMapping describes how to reach objects of a kind.
+	RESTMapping struct {
+		Resource         GroupVersionResource
+		GroupVersionKind GroupVersionKind
+		// Namespaced is true if the objects live in namespaces.
+		Namespaced bool
+	}
+
+	// GroupDiscoveryFailedError is returned when the resources of some group
+	// versions could not be discovered. The resources of the other group
+	// versions are returned with it.
+	GroupDiscoveryFailedError struct {
+		// Groups holds the error for each group version that failed.
+		Groups map[string]error
+	}
+
+	// APIVersions lists the versions that are available at /api.
+	APIVersions struct {
+		TypeMeta `json:",inline"`
+		// versions are the api versions that are available.
+		Versions []string `json:"versions"`
+	}
+
+	// APIGroupList is a list of APIGroup, to allow clients to discover the API at
+	// /apis.
+	APIGroupList struct {
+		TypeMeta `json:",inline"`
+		// groups is a list of APIGroup.
+		Groups []APIGroup `json:"groups"`
+	}
+
+	// APIGroup contains the name, the supported versions, and the preferred version
+	// of a group.
+	APIGroup struct {
+		TypeMeta `json:",inline"`
+		// name is the name of the group.
+		Name string `json:"name"`
+		// versions are the versions supported in this group.
+		Versions []GroupVersionForDiscovery `json:"versions"`
+		// preferredVersion is the version preferred by the API server, which
+		// probably is the storage version.
+		PreferredVersion GroupVersionForDiscovery `json:"preferredVersion,omitempty"`
+	}
+
+	// GroupVersionForDiscovery contains the "group/version" and "version" string of a version.
+	GroupVersionForDiscovery struct {
+		// groupVersion specifies the API group and version in the form "group/version"
+		GroupVersion string `json:"groupVersion"`
+		// version specifies the version in the form of "version".
+		Version string `json:"version"`
+	}
+
+	// APIResourceList is a list of APIResource, it is used to expose the name of the
+	// resources supported in a specific group and version, and if the resource
+	// is namespaced.
+	APIResourceList struct {
+		TypeMeta `json:",inline"`
+		// groupVersion is the group and version this APIResourceList is for.
+		GroupVersion string `json:"groupVersion"`
+		// resources contains the name of the resources and if they are namespaced.
+		APIResources []APIResource `json:"resources"`
+	}
+
+	// APIResource specifies the name of a resource and whether it is namespaced.
+	APIResource struct {
+		// name is the plural name of the resource. Subresources are named
+		// after their resource, such as "pods/log".
+		Name string `json:"name"`
+		// singularName is the singular name of the resource.
+		SingularName string `json:"singularName"`
+		// namespaced indicates if a resource is namespaced or not.
+		Namespaced bool `json:"namespaced"`
+		// group is the preferred group of the resource. Empty implies the group of the containing resource list.
+		Group string `json:"group,omitempty"`
+		// version is the preferred version of the resource. Empty implies the version of the containing resource list.
+		Version string `json:"version,omitempty"`
+		// kind is the kind for the resource (e.g. 'Foo' is the kind for a resource 'foo')
+		Kind string `json:"kind"`
+		// verbs is a list of supported kube verbs (this includes get, list, watch, create,
+		// update, patch, delete, deletecollection, and proxy)
+		Verbs []string `json:"verbs"`
+		// shortNames is a list of suggested short names of the resource.
+		ShortNames []string `json:"shortNames,omitempty"`
+		// categories is a list of the grouped resources this resource belongs to (e.g. 'all')
+		Categories []string `json:"categories,omitempty"`
+	}
+)
+
+func (e *GroupDiscoveryFailedError) Error() string {
+	groupVersions := make([]string, 0, len(e.Groups))
+	for gv := range e.Groups {
+		groupVersions = append(groupVersions, gv)
+	}
+	sort.Strings(groupVersions)
+
+	msgs := make([]string, 0, len(groupVersions))
+	for _, gv := range groupVersions {
+		msgs = append(msgs, gv+": "+e.Groups[gv].Error())
+	}
+	return "unable to retrieve the complete list of server APIs: " + strings.Join(msgs, ", ")
+}
+
+// DeepCopy returns a copy of l that does not share any state.
+func (l *APIGroupList) DeepCopy() *APIGroupList {
+	if l == nil {
+		return nil
+	}
+	out := *l
+	out.Groups = make([]APIGroup, len(l.Groups))
+	for i, g := range l.Groups {
+		if g.Versions != nil {
+			g.Versions = append([]GroupVersionForDiscovery{}, g.Versions...)
+		}
+		out.Groups[i] = g
+	}
+	return &out
+}
+
+// DeepCopy returns a copy of l that does not share any state.
+func (l *APIResourceList) DeepCopy() *APIResourceList {
+	if l == nil {
+		return nil
+	}
+	out := *l
+	out.APIResources = make([]APIResource, len(l.APIResources))
+	for i, r := range l.APIResources {
+		r.Verbs = copyStrings(r.Verbs)
+		r.ShortNames = copyStrings(r.ShortNames)
+		r.Categories = copyStrings(r.Categories)
+		out.APIResources[i] = r
+	}
+	return &out
+}
+
+func copyStrings(s []string) []string {
+	if s == nil {
+		return nil
+	}
+	return append([]string{}, s...)
+}
+
+// PreferredGroupVersion returns the preferred group version of a kind that
+// has typed methods, such as "batch/v1" for "CronJob", or "" for other kinds.
+// It can be used for objects that were built rather than fetched.
+func PreferredGroupVersion(kind string) string {
+	if groupVersions := kindGroupVersions[kind]; len(groupVersions) > 0 {
+		return groupVersions[0]
+	}
+	return ""
+}
+
+// ParseGroupVersion splits an apiVersion, such as "apps/v1" or "v1", into its
+// group and version.
+func ParseGroupVersion(apiVersion string) (group, version string) {
+	if i := strings.Index(apiVersion, "/"); i >= 0 {
+		return apiVersion[:i], apiVersion[i+1:]
+	}
+	return "", apiVersion
+}
+
+// GroupVersionKindOf returns the GroupVersionKind of an object from its
+// apiVersion and kind.
+func GroupVersionKindOf(obj Object) GroupVersionKind {
+	group, version := ParseGroupVersion(obj.GetAPIVersion())
+	return GroupVersionKind{Group: group, Version: version, Kind: obj.GetKind()}
+}
+
+// GroupKind returns the group and kind.
+func (gvk GroupVersionKind) GroupKind() GroupKind {
+	return GroupKind{Group: gvk.Group, Kind: gvk.Kind}
+}
+
+// Path returns the path of the collection of the resource in the namespace,
+// or of the named object if name is not empty. Use an empty namespace for
+// cluster scoped resources or to address all namespaces.
+func (gvr GroupVersionResource) Path(namespace, name string) string {
+	path := "/apis/" + gvr.Group + "/" + gvr.Version
+	if gvr.Group == "" {
+		path = "/api/" + gvr.Version
+	}
+	if namespace != "" {
+		path += "/namespaces/" + namespace
+	}
+	path += "/" + gvr.Resource
+	if name != "" {
+		path += "/" + name
+	}
+	return path
+}
+
+// Path returns the path of the collection or of the named object. namespace
+// is ignored if the kind is not namespaced.
+func (m *RESTMapping) Path(namespace, name string) string {
+	if !m.Namespaced {
+		namespace = ""
+	}
+	return m.Resource.Path(namespace, name)
+}
+
+// Resource returns the resource with the given name, or nil if the group
+// version does not have it.
+func (l *APIResourceList) Resource(name string) *APIResource {
+	for i := range l.APIResources {
+		if l.APIResources[i].Name == name {
+			return &l.APIResources[i]
+		}
+	}
+	return nil
+}
+
+// Subresources returns the names of the subresources of the resource, such
+// as "status" and "scale".
+func (l *APIResourceList) Subresources(resource string) []string {
+	var out []string
+	prefix := resource + "/"
+	for _, r := range l.APIResources {
+		if strings.HasPrefix(r.Name, prefix) {
+			out = append(out, strings.TrimPrefix(r.Name, prefix))
+		}
+	}
+	return out
+}
+
+// HasVerb reports whether the resource supports the verb.
+func (r *APIResource) HasVerb(verb string) bool {
+	for _, v := range r.Verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
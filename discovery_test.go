@@ -0,0 +1,70 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourcePath(t *testing.T) {
+	pods := GroupVersionResource{Version: "v1", Resource: "pods"}
+	assert.Equal(t, "/api/v1/pods", pods.Path("", ""))
+	assert.Equal(t, "/api/v1/namespaces/default/pods/web", pods.Path("default", "web"))
+
+	m := &RESTMapping{Resource: GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}}
+	assert.Equal(t, "/apis/rbac.authorization.k8s.io/v1/clusterroles/admin", m.Path("default", "admin"))
+}
+
+func TestParseGroupVersion(t *testing.T) {
+	group, version := ParseGroupVersion("apps/v1")
+	assert.Equal(t, "apps", group)
+	assert.Equal(t, "v1", version)
+
+	gvk := GroupVersionKindOf(NewConfigMap("default", "test"))
+	assert.Equal(t, GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, gvk)
+}
+
+func TestPreferredGroupVersion(t *testing.T) {
+	assert.Equal(t, "batch/v1", PreferredGroupVersion("CronJob"))
+	assert.Equal(t, "v1", PreferredGroupVersion("Pod"))
+	assert.Equal(t, "", PreferredGroupVersion("CronTab"))
+}
+
+func TestSubresources(t *testing.T) {
+	list := &APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []APIResource{
+			{Name: "pods", Kind: "Pod", Namespaced: true},
+			{Name: "pods/log", Kind: "Pod", Namespaced: true},
+			{Name: "pods/status", Kind: "Pod", Namespaced: true},
+			{Name: "podtemplates", Kind: "PodTemplate", Namespaced: true},
+		},
+	}
+	assert.Equal(t, []string{"log", "status"}, list.Subresources("pods"))
+	assert.Nil(t, list.Subresources("podtemplates"))
+	assert.Nil(t, list.Resource("nodes"))
+}
+
+func TestAPIResourceListDeepCopy(t *testing.T) {
+	list := &APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []APIResource{{Name: "pods", Kind: "Pod", Verbs: []string{"get"}}},
+	}
+	out := list.DeepCopy()
+	out.APIResources[0].Verbs[0] = "delete"
+	out.APIResources = append(out.APIResources, APIResource{Name: "nodes"})
+	assert.Equal(t, []string{"get"}, list.APIResources[0].Verbs)
+	assert.Len(t, list.APIResources, 1)
+}
+
+func TestGroupDiscoveryFailedError(t *testing.T) {
+	err := errors.Wrap(&GroupDiscoveryFailedError{Groups: map[string]error{
+		"metrics.k8s.io/v1beta1":        errors.New("service unavailable"),
+		"custom.metrics.k8s.io/v1beta1": errors.New("service unavailable"),
+	}}, "failed to get preferred resources")
+	assert.True(t, IsGroupDiscoveryFailedError(err))
+	assert.Equal(t, "failed to get preferred resources: unable to retrieve the complete list of server APIs: "+
+		"custom.metrics.k8s.io/v1beta1: service unavailable, metrics.k8s.io/v1beta1: service unavailable", err.Error())
+	assert.False(t, IsGroupDiscoveryFailedError(errors.New("failed")))
+}
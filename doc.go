@@ -1,2 +1,4 @@
 // Package client provides a simple Kubernetes client
 package client
+
+//go:generate go run ./gen
@@ -0,0 +1,342 @@
+// Package drain provides helpers to cordon a node and remove its pods
+// before maintenance.
+package drain
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+const (
+	// mirrorPodAnnotation is set by the kubelet on pods it creates from static manifests.
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+	// createdByAnnotation holds a serialized reference to the controller that created a pod.
+	createdByAnnotation = "kubernetes.io/created-by"
+)
+
+const (
+	// NodeCordoned is reported once the node has been marked unschedulable.
+	NodeCordoned ProgressType = "Cordoned"
+	// PodSkipped is reported for pods that are left on the node, such as
+	// mirror pods and pods managed by a DaemonSet.
+	PodSkipped ProgressType = "Skipped"
+	// PodEvicting is reported when an eviction is requested for a pod.
+	PodEvicting ProgressType = "Evicting"
+	// PodDeleting is reported when a pod is deleted directly.
+	PodDeleting ProgressType = "Deleting"
+	// PodDeleted is reported once a pod is gone from the node.
+	PodDeleted ProgressType = "Deleted"
+	// PodFailed is reported when a pod could not be removed.
+	PodFailed ProgressType = "Failed"
+)
+
+type (
+	// Client is the subset of the Kubernetes client used to drain nodes.
+	Client interface {
+		k8s.NodeInterface
+		k8s.PodInterface
+	}
+
+	// Drainer cordons nodes and removes their pods.
+	Drainer struct {
+		client             Client
+		gracePeriodSeconds *int64
+		concurrency        int
+		disableEviction    bool
+		pollInterval       time.Duration
+		progress           func(Progress)
+	}
+
+	// OptionsFunc is a function passed to New for setting options on a new Drainer.
+	OptionsFunc func(*Drainer) error
+
+	// ProgressType describes what happened to a node or pod while draining.
+	ProgressType string
+
+	// Progress is passed to the progress function as the drain proceeds.
+	Progress struct {
+		Type ProgressType
+		// Node is the name of the node being drained.
+		Node string
+		// Pod is the pod this progress refers to. It is nil for node level progress.
+		Pod *k8s.Pod
+		// Message is a human readable explanation, such as why a pod was skipped.
+		Message string
+		// Err is set when Type is PodFailed.
+		Err error
+	}
+)
+
+// New creates a new Drainer. By default, pods are evicted one at a time using
+// their own grace period.
+func New(c Client, options ...OptionsFunc) (*Drainer, error) {
+	d := &Drainer{
+		client:       c,
+		concurrency:  1,
+		pollInterval: 2 * time.Second,
+	}
+	for _, f := range options {
+		if err := f(d); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// SetGracePeriod sets the grace period, in seconds, given to each pod to
+// terminate. This overrides the pod's own terminationGracePeriodSeconds.
+func SetGracePeriod(seconds int64) func(*Drainer) error {
+	return func(d *Drainer) error {
+		if seconds < 0 {
+			return errors.New("grace period must not be negative")
+		}
+		d.gracePeriodSeconds = &seconds
+		return nil
+	}
+}
+
+// SetConcurrency sets how many pods are removed at the same time.
+func SetConcurrency(n int) func(*Drainer) error {
+	return func(d *Drainer) error {
+		if n < 1 {
+			return errors.New("concurrency must be at least 1")
+		}
+		d.concurrency = n
+		return nil
+	}
+}
+
+// SetDisableEviction deletes pods directly rather than using the eviction
+// API. This bypasses any PodDisruptionBudgets.
+func SetDisableEviction(disable bool) func(*Drainer) error {
+	return func(d *Drainer) error {
+		d.disableEviction = disable
+		return nil
+	}
+}
+
+// SetPollInterval sets how often a pod is checked while waiting for it to
+// terminate and how long to wait before retrying a blocked eviction.
+func SetPollInterval(interval time.Duration) func(*Drainer) error {
+	return func(d *Drainer) error {
+		if interval <= 0 {
+			return errors.New("poll interval must be positive")
+		}
+		d.pollInterval = interval
+		return nil
+	}
+}
+
+// SetProgress sets a function that is called as the drain proceeds. It may
+// be called concurrently when concurrency is greater than 1.
+func SetProgress(f func(Progress)) func(*Drainer) error {
+	return func(d *Drainer) error {
+		d.progress = f
+		return nil
+	}
+}
+
+// Cordon marks the node as unschedulable.
+func (d *Drainer) Cordon(name string) error {
+	return d.setUnschedulable(name, true)
+}
+
+// Uncordon marks the node as schedulable.
+func (d *Drainer) Uncordon(name string) error {
+	return d.setUnschedulable(name, false)
+}
+
+func (d *Drainer) setUnschedulable(name string, unschedulable bool) error {
+	return k8s.RetryOnConflict(k8s.DefaultRetry, func() error {
+		node, err := d.client.GetNode(name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get node %s", name)
+		}
+		if node.Spec.Unschedulable == unschedulable {
+			return nil
+		}
+		node.Spec.Unschedulable = unschedulable
+		_, err = d.client.UpdateNode(node)
+		return errors.Wrapf(err, "failed to update node %s", name)
+	})
+}
+
+// PodsToDrain lists the pods on the node that would be removed by Drain.
+// Mirror pods and pods managed by a DaemonSet are skipped.
+func (d *Drainer) PodsToDrain(name string) ([]k8s.Pod, error) {
+	opts := &k8s.ListOptions{
+		FieldSelector: k8s.FieldSelector{
+			"spec.nodeName": name,
+		},
+	}
+	list, err := d.client.ListPods("", opts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list pods on node %s", name)
+	}
+
+	var pods []k8s.Pod
+	for i := range list.Items {
+		pod := &list.Items[i]
+		if reason := skipReason(pod); reason != "" {
+			d.report(Progress{Type: PodSkipped, Node: name, Pod: pod, Message: reason})
+			continue
+		}
+		pods = append(pods, *pod)
+	}
+	return pods, nil
+}
+
+// Drain cordons the node, then evicts or deletes its pods and waits for them
+// to terminate. Use the context to bound how long Drain may take.
+func (d *Drainer) Drain(ctx context.Context, name string) error {
+	if err := d.Cordon(name); err != nil {
+		return err
+	}
+	d.report(Progress{Type: NodeCordoned, Node: name})
+
+	pods, err := d.PodsToDrain(name)
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, d.concurrency)
+		errs = make(chan error, len(pods))
+	)
+	for i := range pods {
+		wg.Add(1)
+		go func(pod *k8s.Pod) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs <- errors.Wrapf(ctx.Err(), "failed to remove pod %s/%s", pod.Namespace, pod.Name)
+				return
+			}
+			defer func() { <-sem }()
+
+			if err := d.removePod(ctx, name, pod); err != nil {
+				d.report(Progress{Type: PodFailed, Node: name, Pod: pod, Err: err})
+				errs <- err
+				return
+			}
+			d.report(Progress{Type: PodDeleted, Node: name, Pod: pod})
+		}(&pods[i])
+	}
+	wg.Wait()
+	close(errs)
+
+	if err, ok := <-errs; ok {
+		return errors.Wrapf(err, "failed to drain node %s: %d pod(s) not removed", name, len(errs)+1)
+	}
+	return nil
+}
+
+// removePod evicts or deletes the pod and then waits for it to go away.
+func (d *Drainer) removePod(ctx context.Context, node string, pod *k8s.Pod) error {
+	opts := &k8s.DeleteOptions{
+		GracePeriodSeconds: d.gracePeriodSeconds,
+	}
+
+	if d.disableEviction {
+		d.report(Progress{Type: PodDeleting, Node: node, Pod: pod})
+		err := d.client.DeletePodWithOptions(pod.Namespace, pod.Name, opts)
+		if err != nil && !k8s.IsNotFoundError(err) {
+			return errors.Wrapf(err, "failed to delete pod %s/%s", pod.Namespace, pod.Name)
+		}
+		return d.waitForDelete(ctx, pod)
+	}
+
+	d.report(Progress{Type: PodEvicting, Node: node, Pod: pod})
+	for {
+		err := d.client.EvictPod(pod.Namespace, pod.Name, opts)
+		if err == nil || k8s.IsNotFoundError(err) {
+			break
+		}
+		if !k8s.IsTooManyRequestsError(err) {
+			return errors.Wrapf(err, "failed to evict pod %s/%s", pod.Namespace, pod.Name)
+		}
+		// the eviction would violate a disruption budget, so try again later.
+		if err := d.sleep(ctx); err != nil {
+			return errors.Wrapf(err, "failed to evict pod %s/%s", pod.Namespace, pod.Name)
+		}
+	}
+	return d.waitForDelete(ctx, pod)
+}
+
+// waitForDelete waits until the pod no longer exists or has been replaced
+// by a new pod with the same name.
+func (d *Drainer) waitForDelete(ctx context.Context, pod *k8s.Pod) error {
+	for {
+		current, err := d.client.GetPod(pod.Namespace, pod.Name)
+		if k8s.IsNotFoundError(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to get pod %s/%s", pod.Namespace, pod.Name)
+		}
+		if current.UID != pod.UID {
+			return nil
+		}
+		if err := d.sleep(ctx); err != nil {
+			return errors.Wrapf(err, "pod %s/%s did not terminate", pod.Namespace, pod.Name)
+		}
+	}
+}
+
+func (d *Drainer) sleep(ctx context.Context) error {
+	t := time.NewTimer(d.pollInterval)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *Drainer) report(p Progress) {
+	if d.progress != nil {
+		d.progress(p)
+	}
+}
+
+// skipReason returns why a pod should be left on the node, or an empty
+// string if it should be removed.
+func skipReason(pod *k8s.Pod) string {
+	if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+		return "mirror pod"
+	}
+	if ref := k8s.GetControllerOf(pod); ref != nil {
+		if ref.Kind == "DaemonSet" {
+			return "managed by DaemonSet " + ref.Name
+		}
+		return ""
+	}
+	// older clusters only record the creator in an annotation
+	if ref := createdBy(pod); ref != nil && ref.Kind == "DaemonSet" {
+		return "managed by DaemonSet " + ref.Name
+	}
+	return ""
+}
+
+// createdBy returns the reference stored in the created-by annotation, if any.
+func createdBy(pod *k8s.Pod) *k8s.ObjectReference {
+	value, ok := pod.Annotations[createdByAnnotation]
+	if !ok {
+		return nil
+	}
+	var sr struct {
+		Reference k8s.ObjectReference `json:"reference"`
+	}
+	if err := json.Unmarshal([]byte(value), &sr); err != nil {
+		return nil
+	}
+	return &sr.Reference
+}
@@ -0,0 +1,217 @@
+package drain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkipReason(t *testing.T) {
+	pod := k8s.Pod{ObjectMeta: k8s.NewObjectMeta("default", "web")}
+	assert.Equal(t, "", skipReason(&pod), "plain pods should be removed")
+
+	pod.Annotations[createdByAnnotation] = `{"kind":"SerializedReference","apiVersion":"v1","reference":{"kind":"ReplicaSet","name":"web-1"}}`
+	assert.Equal(t, "", skipReason(&pod), "ReplicaSet pods should be removed")
+
+	pod.Annotations[createdByAnnotation] = `{"kind":"SerializedReference","apiVersion":"v1","reference":{"kind":"DaemonSet","name":"fluentd"}}`
+	assert.Equal(t, "managed by DaemonSet fluentd", skipReason(&pod))
+
+	ds := k8s.NewDaemonSet("kube-system", "kube-proxy")
+	ds.UID = "ds-uid"
+	owned := k8s.Pod{ObjectMeta: k8s.NewObjectMeta("kube-system", "kube-proxy-abcde")}
+	assert.Nil(t, k8s.SetControllerReference(ds, &owned))
+	assert.Equal(t, "managed by DaemonSet kube-proxy", skipReason(&owned))
+
+	mirror := k8s.Pod{ObjectMeta: k8s.NewObjectMeta("kube-system", "etcd")}
+	mirror.Annotations[mirrorPodAnnotation] = "abc123"
+	assert.Equal(t, "mirror pod", skipReason(&mirror))
+}
+
+// evictClient wraps the fake client to block evictions and to record how
+// many are in flight at once.
+type evictClient struct {
+	*fake.Client
+
+	mu       sync.Mutex
+	blocked  map[string]int
+	inFlight int
+	max      int
+	evicted  []string
+}
+
+func (c *evictClient) EvictPod(namespace, name string, opts *k8s.DeleteOptions) error {
+	c.mu.Lock()
+	if c.blocked[name] > 0 {
+		c.blocked[name]--
+		c.mu.Unlock()
+		return &k8s.Status{Code: 429, Reason: "TooManyRequests", Message: "disruption budget"}
+	}
+	c.inFlight++
+	if c.inFlight > c.max {
+		c.max = c.inFlight
+	}
+	c.mu.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+	err := c.Client.EvictPod(namespace, name, opts)
+
+	c.mu.Lock()
+	c.inFlight--
+	c.evicted = append(c.evicted, name)
+	c.mu.Unlock()
+	return err
+}
+
+func testPod(name, node string) *k8s.Pod {
+	pod := &k8s.Pod{
+		TypeMeta:   k8s.NewTypeMeta("Pod", "v1"),
+		ObjectMeta: k8s.NewObjectMeta("default", name),
+		Spec:       &k8s.PodSpec{NodeName: node, Containers: []k8s.Container{{Name: "app", Image: "app"}}},
+	}
+	return pod
+}
+
+func testClient(t *testing.T) *evictClient {
+	node := &k8s.Node{TypeMeta: k8s.NewTypeMeta("Node", "v1"), ObjectMeta: k8s.NewObjectMeta("", "node-1")}
+	mirror := testPod("etcd", "node-1")
+	mirror.Annotations[mirrorPodAnnotation] = "abc123"
+
+	objects := []k8s.Object{node, mirror, testPod("other", "node-2")}
+	for i := 0; i < 4; i++ {
+		objects = append(objects, testPod(fmt.Sprintf("web-%d", i), "node-1"))
+	}
+	c, err := fake.New(objects...)
+	require.Nil(t, err)
+	return &evictClient{Client: c, blocked: map[string]int{}}
+}
+
+func podNames(t *testing.T, c Client) []string {
+	list, err := c.ListPods("", nil)
+	require.Nil(t, err)
+	var names []string
+	for _, pod := range list.Items {
+		names = append(names, pod.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestDrain(t *testing.T) {
+	c := testClient(t)
+	c.blocked["web-1"] = 2
+
+	var (
+		mu       sync.Mutex
+		progress = map[ProgressType][]string{}
+	)
+	d, err := New(c,
+		SetConcurrency(2),
+		SetPollInterval(time.Millisecond),
+		SetProgress(func(p Progress) {
+			mu.Lock()
+			defer mu.Unlock()
+			name := p.Node
+			if p.Pod != nil {
+				name = p.Pod.Name
+			}
+			progress[p.Type] = append(progress[p.Type], name)
+		}))
+	require.Nil(t, err)
+
+	require.Nil(t, d.Drain(context.Background(), "node-1"))
+
+	node, err := c.GetNode("node-1")
+	require.Nil(t, err)
+	assert.True(t, node.Spec.Unschedulable)
+	assert.Equal(t, []string{"etcd", "other"}, podNames(t, c))
+
+	assert.Equal(t, 2, c.max, "evictions should be limited to the concurrency")
+	assert.Len(t, c.evicted, 4)
+	assert.Equal(t, 0, c.blocked["web-1"], "blocked evictions should be retried")
+
+	for _, names := range progress {
+		sort.Strings(names)
+	}
+	assert.Equal(t, []string{"node-1"}, progress[NodeCordoned])
+	assert.Equal(t, []string{"etcd"}, progress[PodSkipped])
+	assert.Equal(t, []string{"web-0", "web-1", "web-2", "web-3"}, progress[PodEvicting])
+	assert.Equal(t, []string{"web-0", "web-1", "web-2", "web-3"}, progress[PodDeleted])
+	assert.Empty(t, progress[PodFailed])
+
+	require.Nil(t, d.Uncordon("node-1"))
+	node, err = c.GetNode("node-1")
+	require.Nil(t, err)
+	assert.False(t, node.Spec.Unschedulable)
+}
+
+func TestDrainDisableEviction(t *testing.T) {
+	c := testClient(t)
+
+	var deleting []string
+	d, err := New(c,
+		SetDisableEviction(true),
+		SetGracePeriod(0),
+		SetProgress(func(p Progress) {
+			if p.Type == PodDeleting {
+				deleting = append(deleting, p.Pod.Name)
+			}
+		}))
+	require.Nil(t, err)
+
+	require.Nil(t, d.Drain(context.Background(), "node-1"))
+	assert.Equal(t, []string{"etcd", "other"}, podNames(t, c))
+	assert.Len(t, deleting, 4)
+	assert.Empty(t, c.evicted)
+}
+
+func TestDrainTimeout(t *testing.T) {
+	c := testClient(t)
+	c.blocked["web-2"] = 1000
+
+	var failed []string
+	d, err := New(c,
+		SetConcurrency(4),
+		SetPollInterval(time.Millisecond),
+		SetProgress(func(p Progress) {
+			if p.Type == PodFailed {
+				failed = append(failed, p.Pod.Name)
+			}
+		}))
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err = d.Drain(ctx, "node-1")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "1 pod(s) not removed")
+	assert.Equal(t, []string{"web-2"}, failed)
+	assert.Equal(t, []string{"etcd", "other", "web-2"}, podNames(t, c))
+}
+
+func TestWaitForDelete(t *testing.T) {
+	c := testClient(t)
+	d, err := New(c, SetPollInterval(time.Millisecond))
+	require.Nil(t, err)
+
+	pod, err := c.GetPod("default", "web-0")
+	require.Nil(t, err)
+
+	// a pod that is still there is waited for
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.NotNil(t, d.waitForDelete(ctx, pod))
+
+	// a new pod with the same name is not the one being waited for
+	require.Nil(t, c.DeletePod("default", "web-0"))
+	_, err = c.CreatePod("default", testPod("web-0", "node-1"))
+	require.Nil(t, err)
+	assert.Nil(t, d.waitForDelete(context.Background(), pod))
+}
@@ -1,21 +1,6 @@
 package client
 
 type (
-	// EndpointsInterface has methods to work with Endpoints resources.
-	EndpointsInterface interface {
-		CreateEndpoints(namespace string, item *Endpoints) (*Endpoints, error)
-		GetEndpoints(namespace, name string) (result *Endpoints, err error)
-		ListEndpoints(namespace string, opts *ListOptions) (*EndpointsList, error)
-		WatchEndpoints(namespace string, opts *WatchOptions, events chan EndpointsWatchEvent) error
-		DeleteEndpoints(namespace, name string) error
-		UpdateEndpoints(namespace string, item *Endpoints) (*Endpoints, error)
-	}
-
-	EndpointsWatchEvent interface {
-		Type() WatchEventType
-		Object() (*Endpoints, error)
-	}
-
 	// Endpoints is a collection of endpoints that implement the actual service.
 	Endpoints struct {
 		TypeMeta   `json:",inline"`
@@ -29,6 +14,9 @@ type (
 		// No address will appear in both Addresses and NotReadyAddresses in the same subset.
 		// Sets of addresses and ports that comprise a service
 		Subsets []EndpointSubset `json:"subsets,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// EndpointSubset is a group of addresses with a common set of ports.
@@ -78,3 +66,17 @@ type (
 		Items []Endpoints `json:"items"`
 	}
 )
+
+// UnmarshalJSON decodes the Endpoints, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (e *Endpoints) UnmarshalJSON(data []byte) error {
+	type alias Endpoints
+	return unmarshalKeepingUnknown(data, (*alias)(e), &e.raw)
+}
+
+// MarshalJSON encodes the Endpoints, including any fields it was decoded with
+// that this package does not model.
+func (e Endpoints) MarshalJSON() ([]byte, error) {
+	type alias Endpoints
+	return marshalKeepingUnknown(alias(e), e.raw)
+}
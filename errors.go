@@ -7,3 +7,26 @@ func IsNotFoundError(err error) bool {
 	s, ok := errors.Cause(err).(*Status)
 	return ok && s.Code == 404
 }
+
+// IsTooManyRequestsError can be used to check if the error was a too many
+// requests error. An eviction that would violate a PodDisruptionBudget fails
+// with this error and may be retried later.
+func IsTooManyRequestsError(err error) bool {
+	s, ok := errors.Cause(err).(*Status)
+	return ok && s.Code == 429
+}
+
+// IsConflictError can be used to check if the error was a conflict error,
+// such as an update made with a stale resource version.
+func IsConflictError(err error) bool {
+	s, ok := errors.Cause(err).(*Status)
+	return ok && s.Code == 409
+}
+
+// IsGroupDiscoveryFailedError can be used to check if discovery failed for
+// only some group versions, in which case the results of the others were
+// returned.
+func IsGroupDiscoveryFailedError(err error) bool {
+	_, ok := errors.Cause(err).(*GroupDiscoveryFailedError)
+	return ok
+}
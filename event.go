@@ -0,0 +1,126 @@
+package client
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	// EventTypeNormal is for information only and will not cause any problems.
+	EventTypeNormal = "Normal"
+	// EventTypeWarning means something might go wrong.
+	EventTypeWarning = "Warning"
+)
+
+type (
+	// Event is a report of an event somewhere in the cluster.
+	Event struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// The object that this event is about.
+		InvolvedObject ObjectReference `json:"involvedObject"`
+
+		// This should be a short, machine understandable string that gives the
+		// reason for the transition into the object's current status.
+		Reason string `json:"reason,omitempty"`
+
+		// A human-readable description of the status of this operation.
+		Message string `json:"message,omitempty"`
+
+		// The component reporting this event. Should be a short machine understandable string.
+		Source EventSource `json:"source,omitempty"`
+
+		// The time at which the event was first recorded. (Time of server receipt is in TypeMeta.)
+		FirstTimestamp Time `json:"firstTimestamp,omitempty"`
+
+		// The time at which the most recent occurrence of this event was recorded.
+		LastTimestamp Time `json:"lastTimestamp,omitempty"`
+
+		// The number of times this event has occurred.
+		Count int32 `json:"count,omitempty"`
+
+		// Type of this event (Normal, Warning), new types could be added in the future
+		Type string `json:"type,omitempty"`
+
+		// What action was taken/failed regarding to the Regarding object.
+		Action string `json:"action,omitempty"`
+
+		// Optional secondary object for more complex actions.
+		Related *ObjectReference `json:"related,omitempty"`
+
+		// Name of the controller that emitted this Event, e.g. `kubernetes.io/kubelet`.
+		ReportingController string `json:"reportingComponent"`
+
+		// ID of the controller instance, e.g. `kubelet-xyzf`.
+		ReportingInstance string `json:"reportingInstance"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// EventSource contains information for an event.
+	EventSource struct {
+		// Component from which the event is generated.
+		Component string `json:"component,omitempty"`
+		// Node name on which the event is generated.
+		Host string `json:"host,omitempty"`
+	}
+
+	// EventList is a list of events.
+	EventList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is a list of events
+		Items []Event `json:"items"`
+	}
+)
+
+// NewEvent creates a new Event struct
+func NewEvent(namespace, name string) *Event {
+	return &Event{
+		TypeMeta:   NewTypeMeta("Event", "v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+	}
+}
+
+// ListEventsFor lists the events about the object. The object's kind must
+// be set, which is not the case for the items of a list. Events about
+// cluster scoped objects are listed across all namespaces.
+func ListEventsFor(c EventInterface, obj Object) (*EventList, error) {
+	ref, err := GetReference(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := FieldSelector{
+		"involvedObject.kind": ref.Kind,
+		"involvedObject.name": ref.Name,
+	}
+	if ref.Namespace != "" {
+		fields["involvedObject.namespace"] = ref.Namespace
+	}
+	if ref.UID != "" {
+		fields["involvedObject.uid"] = string(ref.UID)
+	}
+
+	list, err := c.ListEvents(ref.Namespace, &ListOptions{FieldSelector: fields})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list events for %s %q", ref.Kind, ref.Name)
+	}
+	return list, nil
+}
+
+// UnmarshalJSON decodes the Event, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type alias Event
+	return unmarshalKeepingUnknown(data, (*alias)(e), &e.raw)
+}
+
+// MarshalJSON encodes the Event, including any fields it was decoded with
+// that this package does not model.
+func (e Event) MarshalJSON() ([]byte, error) {
+	type alias Event
+	return marshalKeepingUnknown(alias(e), e.raw)
+}
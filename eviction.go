@@ -0,0 +1,25 @@
+package client
+
+type (
+	// Eviction evicts a pod from its node subject to certain policies and safety constraints.
+	// This is a subresource of Pod. A request to cause such an eviction is
+	// created by POSTing to .../pods/<pod name>/eviction.
+	Eviction struct {
+		TypeMeta `json:",inline"`
+
+		// ObjectMeta describes the pod that is being evicted.
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// DeleteOptions may be provided
+		DeleteOptions *DeleteOptions `json:"deleteOptions,omitempty"`
+	}
+)
+
+// NewEviction creates a new Eviction struct. Clients set the API version to
+// the policy group version the server supports before sending it.
+func NewEviction(namespace, name string) *Eviction {
+	return &Eviction{
+		TypeMeta:   NewTypeMeta("Eviction", "policy/v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+	}
+}
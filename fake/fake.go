@@ -0,0 +1,491 @@
+// Package fake provides an in-memory client for testing code that uses the
+// k8s.Client interface.
+//
+// Objects are stored as the API server would store them: creating an object
+// sets its uid, resource version and creation timestamp, updates fail with a
+// conflict if the resource version is stale and watches see every change.
+// There are no controllers, so a Deployment will never create a ReplicaSet,
+// and the status of an object only changes when it is updated.
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+var _ k8s.Client = &Client{}
+
+type (
+	// Client is an in-memory k8s.Client. The typed methods for each kind are
+	// generated from gen/kinds.json.
+	Client struct {
+		// Version is returned by ServerVersion.
+		Version k8s.VersionInfo
+		// Resources are served by discovery. They default to the kinds with
+		// typed methods. Append to them to use other resources, such as
+		// custom resources, with the dynamic client.
+		Resources []*k8s.APIResourceList
+		// Authorize answers access reviews. Self reviews are passed without
+		// a user. If it is nil every request is allowed.
+		Authorize func(spec k8s.SubjectAccessReviewSpec) (allowed bool, reason string)
+		// Authenticate answers token reviews. If it is nil no token is
+		// authenticated.
+		Authenticate func(spec k8s.TokenReviewSpec) (*k8s.UserInfo, bool)
+
+		tracker *tracker
+	}
+
+	// kind is a kind with typed methods at one of its group versions.
+	kind struct {
+		gvk          k8s.GroupVersionKind
+		resource     string
+		namespaced   bool
+		subresources []string
+	}
+
+	dynamicResource struct {
+		client   *Client
+		resource k8s.GroupVersionResource
+	}
+
+	watchEventUnstructured struct {
+		raw    k8s.WatchEvent
+		object *k8s.Unstructured
+	}
+)
+
+// New creates a Client holding the given objects. Typed objects, such as
+// *k8s.Pod, may be given without a kind or apiVersion. Other objects, such as
+// *k8s.Unstructured, must have both and be listed in Resources.
+func New(objects ...k8s.Object) (*Client, error) {
+	c := &Client{
+		Version: k8s.VersionInfo{
+			Major:      "1",
+			Minor:      "16",
+			GitVersion: "v1.16.0",
+			Platform:   "linux/amd64",
+		},
+		Resources: defaultResources(),
+		tracker:   newTracker(),
+	}
+	for _, k := range kinds {
+		for _, sub := range k.subresources {
+			if sub == "status" {
+				key := resourceKey(k8s.GroupVersionResource{Group: k.gvk.Group, Resource: k.resource})
+				c.tracker.hasStatus[key] = true
+			}
+		}
+	}
+	if err := c.Add(objects...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Add adds objects to the client, as if they had been created.
+func (c *Client) Add(objects ...k8s.Object) error {
+	for _, obj := range objects {
+		ok, err := c.addTyped(obj)
+		if err != nil {
+			return errors.Wrapf(err, "failed to add %s %s", obj.GetKind(), obj.GetName())
+		}
+		if ok {
+			continue
+		}
+
+		gvk := k8s.GroupVersionKindOf(obj)
+		mapping, err := c.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return errors.Wrapf(err, "failed to add %s %s", obj.GetKind(), obj.GetName())
+		}
+		var namespace string
+		if o, ok := obj.(k8s.NamespacedObject); ok && mapping.Namespaced {
+			namespace = o.GetNamespace()
+		}
+		if err := c.tracker.create(mapping.Resource, namespace, obj, nil); err != nil {
+			return errors.Wrapf(err, "failed to add %s %s", obj.GetKind(), obj.GetName())
+		}
+	}
+	return nil
+}
+
+// StopWatches ends all running watches, as the API server does from time
+// to time. It can be used to test that callers watch again.
+func (c *Client) StopWatches() {
+	c.tracker.stopWatches()
+}
+
+// DeletePodWithOptions deletes a single Pod. The grace period is ignored and
+// the Pod is removed immediately.
+func (c *Client) DeletePodWithOptions(namespace, name string, opts *k8s.DeleteOptions) error {
+	if opts != nil && opts.Preconditions != nil && opts.Preconditions.UID != nil {
+		pod, err := c.GetPod(namespace, name)
+		if err != nil {
+			return errors.Wrap(err, "failed to delete Pod")
+		}
+		if pod.UID != *opts.Preconditions.UID {
+			return errors.Wrap(
+				newStatus(http.StatusConflict, "Conflict", "precondition failed: UID in precondition: %s, UID in object meta: %s", *opts.Preconditions.UID, pod.UID),
+				"failed to delete Pod")
+		}
+	}
+	return c.DeletePod(namespace, name)
+}
+
+// EvictPod removes a single Pod. PodDisruptionBudgets are not checked.
+func (c *Client) EvictPod(namespace, name string, opts *k8s.DeleteOptions) error {
+	err := c.DeletePodWithOptions(namespace, name, opts)
+	return errors.Wrap(err, "failed to evict Pod")
+}
+
+// ResizePersistentVolumeClaim patches the storage request of a single
+// PersistentVolumeClaim. Nothing resizes the volume, so the capacity in the
+// status is left as it is.
+func (c *Client) ResizePersistentVolumeClaim(namespace, name string, size k8s.Quantity) (*k8s.PersistentVolumeClaim, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": k8s.ResourceList{k8s.ResourceStorage: size},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode patch")
+	}
+	var out k8s.PersistentVolumeClaim
+	if err := c.tracker.patch(persistentvolumeclaimResource, namespace, name, k8s.MergePatchType, data, &out); err != nil {
+		return nil, errors.Wrap(err, "failed to resize PersistentVolumeClaim")
+	}
+	return &out, nil
+}
+
+// CreateSelfSubjectAccessReview answers the review using Authorize.
+func (c *Client) CreateSelfSubjectAccessReview(item *k8s.SelfSubjectAccessReview) (*k8s.SelfSubjectAccessReview, error) {
+	out := *item
+	out.TypeMeta = k8s.NewTypeMeta("SelfSubjectAccessReview", "authorization.k8s.io/v1")
+	out.Status = c.authorize(k8s.SubjectAccessReviewSpec{
+		ResourceAttributes:    item.Spec.ResourceAttributes,
+		NonResourceAttributes: item.Spec.NonResourceAttributes,
+	})
+	return &out, nil
+}
+
+// CreateSubjectAccessReview answers the review using Authorize.
+func (c *Client) CreateSubjectAccessReview(item *k8s.SubjectAccessReview) (*k8s.SubjectAccessReview, error) {
+	out := *item
+	out.TypeMeta = k8s.NewTypeMeta("SubjectAccessReview", "authorization.k8s.io/v1")
+	out.Status = c.authorize(item.Spec)
+	return &out, nil
+}
+
+func (c *Client) authorize(spec k8s.SubjectAccessReviewSpec) *k8s.SubjectAccessReviewStatus {
+	if c.Authorize == nil {
+		return &k8s.SubjectAccessReviewStatus{Allowed: true}
+	}
+	allowed, reason := c.Authorize(spec)
+	return &k8s.SubjectAccessReviewStatus{Allowed: allowed, Reason: reason}
+}
+
+// CreateTokenReview answers the review using Authenticate.
+func (c *Client) CreateTokenReview(item *k8s.TokenReview) (*k8s.TokenReview, error) {
+	out := *item
+	out.TypeMeta = k8s.NewTypeMeta("TokenReview", "authentication.k8s.io/v1")
+	out.Status = &k8s.TokenReviewStatus{}
+	if c.Authenticate != nil {
+		if user, ok := c.Authenticate(item.Spec); ok && user != nil {
+			out.Status.Authenticated = true
+			out.Status.User = *user
+			out.Status.Audiences = item.Spec.Audiences
+		}
+	}
+	return &out, nil
+}
+
+// ServerVersion returns Version.
+func (c *Client) ServerVersion() (*k8s.VersionInfo, error) {
+	version := c.Version
+	return &version, nil
+}
+
+// ServerGroups returns the groups in Resources. The core group is first and
+// the first version listed for a group is its preferred version.
+func (c *Client) ServerGroups() (*k8s.APIGroupList, error) {
+	groups := []k8s.APIGroup{{}}
+	index := map[string]int{"": 0}
+	for _, list := range c.Resources {
+		group, version := k8s.ParseGroupVersion(list.GroupVersion)
+		i, ok := index[group]
+		if !ok {
+			i = len(groups)
+			index[group] = i
+			groups = append(groups, k8s.APIGroup{Name: group})
+		}
+		g := &groups[i]
+		if hasVersion(g, version) {
+			continue
+		}
+		v := k8s.GroupVersionForDiscovery{GroupVersion: list.GroupVersion, Version: version}
+		g.Versions = append(g.Versions, v)
+		if len(g.Versions) == 1 {
+			g.PreferredVersion = v
+		}
+	}
+	return &k8s.APIGroupList{Groups: groups}, nil
+}
+
+// ServerResourcesForGroupVersion returns the resources in Resources for the
+// group version.
+func (c *Client) ServerResourcesForGroupVersion(groupVersion string) (*k8s.APIResourceList, error) {
+	for _, list := range c.Resources {
+		if list.GroupVersion == groupVersion {
+			return list, nil
+		}
+	}
+	return nil, errors.Wrapf(
+		newStatus(http.StatusNotFound, "NotFound", "the server could not find the requested resource"),
+		"failed to get API resources for %s", groupVersion)
+}
+
+// RESTMapping returns the resource for a kind from Resources.
+func (c *Client) RESTMapping(kind k8s.GroupKind, versions ...string) (*k8s.RESTMapping, error) {
+	groups, err := c.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var group *k8s.APIGroup
+	for i := range groups.Groups {
+		if groups.Groups[i].Name == kind.Group {
+			group = &groups.Groups[i]
+			break
+		}
+	}
+	if group == nil {
+		return nil, errors.Errorf("no API group %q for kind %s", kind.Group, kind.Kind)
+	}
+	if len(versions) == 0 {
+		for _, v := range group.Versions {
+			versions = append(versions, v.Version)
+		}
+	}
+
+	for _, version := range versions {
+		gv := k8s.GroupVersionResource{Group: kind.Group, Version: version}.GroupVersion()
+		list, err := c.ServerResourcesForGroupVersion(gv)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			if r.Kind != kind.Kind || strings.Contains(r.Name, "/") {
+				continue
+			}
+			return &k8s.RESTMapping{
+				Resource:         k8s.GroupVersionResource{Group: kind.Group, Version: version, Resource: r.Name},
+				GroupVersionKind: k8s.GroupVersionKind{Group: kind.Group, Version: version, Kind: kind.Kind},
+				Namespaced:       r.Namespaced,
+			}, nil
+		}
+	}
+	return nil, errors.Errorf("no resource for kind %s in group %q at versions %v", kind.Kind, kind.Group, versions)
+}
+
+func hasVersion(group *k8s.APIGroup, version string) bool {
+	for _, v := range group.Versions {
+		if v.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultResources lists the kinds with typed methods for discovery.
+func defaultResources() []*k8s.APIResourceList {
+	var out []*k8s.APIResourceList
+	lists := make(map[string]*k8s.APIResourceList)
+	for _, k := range kinds {
+		gv := k8s.GroupVersionResource{Group: k.gvk.Group, Version: k.gvk.Version}.GroupVersion()
+		list, ok := lists[gv]
+		if !ok {
+			list = &k8s.APIResourceList{
+				TypeMeta:     k8s.NewTypeMeta("APIResourceList", "v1"),
+				GroupVersion: gv,
+			}
+			lists[gv] = list
+			out = append(out, list)
+		}
+
+		list.APIResources = append(list.APIResources, k8s.APIResource{
+			Name:         k.resource,
+			SingularName: strings.ToLower(k.gvk.Kind),
+			Namespaced:   k.namespaced,
+			Kind:         k.gvk.Kind,
+			Verbs:        []string{"create", "delete", "get", "list", "patch", "update", "watch"},
+		})
+		for _, sub := range k.subresources {
+			verbs := []string{"create"}
+			if sub == "status" {
+				verbs = []string{"get", "patch", "update"}
+			}
+			list.APIResources = append(list.APIResources, k8s.APIResource{
+				Name:       k.resource + "/" + sub,
+				Namespaced: k.namespaced,
+				Kind:       k.gvk.Kind,
+				Verbs:      verbs,
+			})
+		}
+	}
+	return out
+}
+
+// Resource returns a client for the given resource. Objects created through
+// it are seen by the typed methods and the other way round.
+func (c *Client) Resource(resource k8s.GroupVersionResource) k8s.DynamicResourceInterface {
+	return &dynamicResource{
+		client:   c,
+		resource: resource,
+	}
+}
+
+func (w *watchEventUnstructured) Type() k8s.WatchEventType {
+	return w.raw.Type
+}
+
+func (w *watchEventUnstructured) Object() (*k8s.Unstructured, error) {
+	if w.object != nil {
+		return w.object, nil
+	}
+	if w.raw.Type == k8s.WatchEventTypeError {
+		var status k8s.Status
+		if err := w.raw.UnmarshalObject(&status); err != nil {
+			return nil, errors.Wrap(err, "failed to decode Status")
+		}
+		return nil, &status
+	}
+	var object k8s.Unstructured
+	if err := w.raw.UnmarshalObject(&object); err != nil {
+		return nil, errors.Wrap(err, "failed to decode object")
+	}
+	w.object = &object
+	return &object, nil
+}
+
+// scope returns the namespace to use for the resource: "" if it is cluster
+// scoped, as found in Resources, and namespace otherwise.
+func (r *dynamicResource) scope(namespace string) (string, error) {
+	list, err := r.client.ServerResourcesForGroupVersion(r.resource.GroupVersion())
+	if err != nil {
+		return "", err
+	}
+	resource := list.Resource(r.resource.Resource)
+	if resource == nil {
+		return "", newStatus(http.StatusNotFound, "NotFound", "the server does not have resource %s", r.resource)
+	}
+	if !resource.Namespaced {
+		return "", nil
+	}
+	return namespace, nil
+}
+
+func (r *dynamicResource) Get(namespace, name string) (*k8s.Unstructured, error) {
+	namespace, err := r.scope(namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", r.resource.Resource)
+	}
+	var out k8s.Unstructured
+	if err := r.client.tracker.get(r.resource, namespace, name, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", r.resource.Resource)
+	}
+	return &out, nil
+}
+
+func (r *dynamicResource) List(namespace string, opts *k8s.ListOptions) (*k8s.UnstructuredList, error) {
+	namespace, err := r.scope(namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list %s", r.resource.Resource)
+	}
+	var out k8s.UnstructuredList
+	if err := r.client.tracker.list(r.resource, namespace, opts, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to list %s", r.resource.Resource)
+	}
+	return &out, nil
+}
+
+func (r *dynamicResource) Create(namespace string, item *k8s.Unstructured) (*k8s.Unstructured, error) {
+	namespace, err := r.scope(namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", r.resource.Resource)
+	}
+	if namespace != "" {
+		item.SetNamespace(namespace)
+	}
+	var out k8s.Unstructured
+	if err := r.client.tracker.create(r.resource, namespace, item, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", r.resource.Resource)
+	}
+	return &out, nil
+}
+
+func (r *dynamicResource) Update(namespace string, item *k8s.Unstructured) (*k8s.Unstructured, error) {
+	namespace, err := r.scope(namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update %s", r.resource.Resource)
+	}
+	if namespace != "" {
+		item.SetNamespace(namespace)
+	}
+	var out k8s.Unstructured
+	if err := r.client.tracker.update(r.resource, namespace, item, &out, ""); err != nil {
+		return nil, errors.Wrapf(err, "failed to update %s", r.resource.Resource)
+	}
+	return &out, nil
+}
+
+func (r *dynamicResource) Delete(namespace, name string) error {
+	namespace, err := r.scope(namespace)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete %s", r.resource.Resource)
+	}
+	err = r.client.tracker.delete(r.resource, namespace, name)
+	return errors.Wrapf(err, "failed to delete %s", r.resource.Resource)
+}
+
+func (r *dynamicResource) Watch(namespace string, opts *k8s.WatchOptions, events chan k8s.UnstructuredWatchEvent) error {
+	if events == nil {
+		return errors.New("events must not be nil")
+	}
+	defer close(events)
+	namespace, err := r.scope(namespace)
+	if err != nil {
+		return errors.Wrapf(err, "failed to watch %s", r.resource.Resource)
+	}
+
+	rawEvents := make(chan k8s.WatchEvent)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for rawEvent := range rawEvents {
+			events <- &watchEventUnstructured{raw: rawEvent}
+		}
+	}()
+	err = r.client.tracker.watch(r.resource, namespace, opts, rawEvents)
+	<-done
+	return errors.Wrapf(err, "failed to watch %s", r.resource.Resource)
+}
+
+// Patch supports merge patches. Strategic merge patches are applied as merge
+// patches, so lists are replaced rather than merged by key.
+func (r *dynamicResource) Patch(namespace, name string, pt k8s.PatchType, data []byte) (*k8s.Unstructured, error) {
+	namespace, err := r.scope(namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to patch %s", r.resource.Resource)
+	}
+	var out k8s.Unstructured
+	if err := r.client.tracker.patch(r.resource, namespace, name, pt, data, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to patch %s", r.resource.Resource)
+	}
+	return &out, nil
+}
@@ -0,0 +1,254 @@
+package fake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWithObjects(t *testing.T) {
+	crontab := k8s.NewUnstructured("stable.example.com/v1", "CronTab", "default", "backup")
+	c, err := New(
+		&k8s.Pod{ObjectMeta: k8s.NewObjectMeta("default", "web")},
+		&k8s.Node{ObjectMeta: k8s.NewObjectMeta("", "node-1")},
+	)
+	require.Nil(t, err)
+
+	pod, err := c.GetPod("default", "web")
+	require.Nil(t, err)
+	assert.Equal(t, "Pod", pod.Kind)
+	assert.Equal(t, "v1", pod.APIVersion)
+
+	_, err = c.GetNode("node-1")
+	require.Nil(t, err)
+
+	assert.NotNil(t, c.Add(crontab), "unknown kinds must be in Resources")
+
+	c.Resources = append(c.Resources, &k8s.APIResourceList{
+		GroupVersion: "stable.example.com/v1",
+		APIResources: []k8s.APIResource{{Name: "crontabs", Namespaced: true, Kind: "CronTab"}},
+	})
+	require.Nil(t, c.Add(crontab))
+	got, err := c.Resource(k8s.GroupVersionResource{Group: "stable.example.com", Version: "v1", Resource: "crontabs"}).Get("default", "backup")
+	require.Nil(t, err)
+	assert.NotEmpty(t, got.GetUID())
+}
+
+func TestUpdateStatus(t *testing.T) {
+	c, err := New()
+	require.Nil(t, err)
+
+	d := k8s.NewDeployment("default", "web")
+	d, err = c.CreateDeployment("default", d)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), d.Generation)
+
+	replicas := d.Spec.Replicas
+	d.Status = &k8s.DeploymentStatus{ObservedGeneration: 1, Replicas: 3}
+	d.Spec.Replicas = replicas + 5
+	updated, err := c.UpdateDeploymentStatus("default", d)
+	require.Nil(t, err)
+	assert.Equal(t, 3, updated.Status.Replicas)
+	assert.Equal(t, replicas, updated.Spec.Replicas, "status updates should not change the spec")
+
+	updated.Spec.Replicas = replicas + 5
+	updated.Status.Replicas = 0
+	updated, err = c.UpdateDeployment("default", updated)
+	require.Nil(t, err)
+	assert.Equal(t, replicas+5, updated.Spec.Replicas)
+	assert.Equal(t, 3, updated.Status.Replicas, "updates should not change the status")
+	assert.Equal(t, int64(2), updated.Generation)
+}
+
+func TestWatch(t *testing.T) {
+	c, err := New(&k8s.Pod{ObjectMeta: k8s.NewObjectMeta("default", "existing")})
+	require.Nil(t, err)
+
+	events := make(chan k8s.PodWatchEvent)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- c.WatchPods("default", nil, events)
+	}()
+
+	next := func() (k8s.WatchEventType, string) {
+		select {
+		case ev := <-events:
+			pod, err := ev.Object()
+			require.Nil(t, err)
+			return ev.Type(), pod.Name
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+		return "", ""
+	}
+
+	typ, name := next()
+	assert.Equal(t, k8s.WatchEventTypeAdded, typ)
+	assert.Equal(t, "existing", name)
+
+	_, err = c.CreatePod("other", &k8s.Pod{ObjectMeta: k8s.NewObjectMeta("", "elsewhere")})
+	require.Nil(t, err)
+	_, err = c.CreatePod("default", &k8s.Pod{ObjectMeta: k8s.NewObjectMeta("", "new")})
+	require.Nil(t, err)
+	typ, name = next()
+	assert.Equal(t, k8s.WatchEventTypeAdded, typ)
+	assert.Equal(t, "new", name, "pods in other namespaces should not be seen")
+
+	require.Nil(t, c.DeletePod("default", "existing"))
+	typ, name = next()
+	assert.Equal(t, k8s.WatchEventTypeDeleted, typ)
+	assert.Equal(t, "existing", name)
+
+	c.StopWatches()
+	for range events {
+	}
+	assert.Nil(t, <-errc)
+}
+
+func TestWaitFor(t *testing.T) {
+	c, err := New(&k8s.Pod{ObjectMeta: k8s.NewObjectMeta("default", "web")})
+	require.Nil(t, err)
+
+	go func() {
+		pod, err := c.GetPod("default", "web")
+		if err != nil {
+			return
+		}
+		pod.Status = &k8s.PodStatus{Phase: k8s.PodRunning}
+		_, _ = c.UpdatePodStatus("default", pod)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	obj, err := k8s.WaitFor(ctx, k8s.PodListWatcher(c, "default", "web"), func(obj k8s.Object) (bool, error) {
+		pod, ok := obj.(*k8s.Pod)
+		return ok && pod.Status != nil && pod.Status.Phase == k8s.PodRunning, nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "web", obj.GetName())
+}
+
+func TestDynamic(t *testing.T) {
+	c, err := New()
+	require.Nil(t, err)
+
+	configMaps := c.Resource(k8s.GroupVersionResource{Version: "v1", Resource: "configmaps"})
+	item := k8s.NewUnstructured("v1", "ConfigMap", "", "settings")
+	require.Nil(t, k8s.SetNestedField(item.Object, "blue", "data", "color"))
+	_, err = configMaps.Create("default", item)
+	require.Nil(t, err)
+
+	typed, err := c.GetConfigMap("default", "settings")
+	require.Nil(t, err)
+	assert.Equal(t, "blue", typed.Data["color"])
+
+	patched, err := configMaps.Patch("default", "settings", k8s.MergePatchType, []byte(`{"data":{"color":null,"size":"large"}}`))
+	require.Nil(t, err)
+	data, _, err := k8s.NestedStringMap(patched.Object, "data")
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"size": "large"}, data)
+
+	_, err = configMaps.Patch("default", "settings", k8s.JSONPatchType, []byte(`[]`))
+	assert.NotNil(t, err)
+
+	_, err = configMaps.Patch("default", "missing", k8s.MergePatchType, []byte(`{}`))
+	assert.True(t, k8s.IsNotFoundError(err))
+}
+
+func TestDiscovery(t *testing.T) {
+	c, err := New()
+	require.Nil(t, err)
+
+	groups, err := c.ServerGroups()
+	require.Nil(t, err)
+	require.NotEmpty(t, groups.Groups)
+	assert.Equal(t, "", groups.Groups[0].Name)
+
+	mapping, err := c.RESTMapping(k8s.GroupKind{Group: "apps", Kind: "Deployment"})
+	require.Nil(t, err)
+	assert.Equal(t, k8s.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, mapping.Resource)
+	assert.True(t, mapping.Namespaced)
+
+	mapping, err = c.RESTMapping(k8s.GroupKind{Kind: "Node"})
+	require.Nil(t, err)
+	assert.False(t, mapping.Namespaced)
+
+	resources, err := c.ServerResourcesForGroupVersion("v1")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"status", "eviction"}, resources.Subresources("pods"))
+
+	_, err = c.ServerResourcesForGroupVersion("example.com/v1")
+	assert.True(t, k8s.IsNotFoundError(err))
+}
+
+func TestResizePersistentVolumeClaim(t *testing.T) {
+	claim := k8s.NewPersistentVolumeClaim("default", "data")
+	claim.Spec.AccessModes = []k8s.PersistentVolumeAccessMode{k8s.ReadWriteOnce}
+	claim.Spec.Resources.Requests = k8s.ResourceList{k8s.ResourceStorage: k8s.MustParse("1Gi")}
+	c, err := New(claim)
+	require.Nil(t, err)
+
+	out, err := c.ResizePersistentVolumeClaim("default", "data", k8s.MustParse("10Gi"))
+	require.Nil(t, err)
+	size := out.Spec.Resources.Requests[k8s.ResourceStorage]
+	assert.Equal(t, 0, size.Cmp(k8s.MustParse("10Gi")))
+	assert.Equal(t, []k8s.PersistentVolumeAccessMode{k8s.ReadWriteOnce}, out.Spec.AccessModes)
+
+	_, err = c.ResizePersistentVolumeClaim("default", "missing", k8s.MustParse("10Gi"))
+	assert.True(t, k8s.IsNotFoundError(err))
+}
+
+func TestReviews(t *testing.T) {
+	c, err := New()
+	require.Nil(t, err)
+
+	review, err := c.CreateSelfSubjectAccessReview(k8s.NewSelfSubjectAccessReview(k8s.ResourceAttributes{Verb: "get", Resource: "pods"}))
+	require.Nil(t, err)
+	assert.True(t, review.Status.Allowed, "everything is allowed by default")
+
+	c.Authorize = func(spec k8s.SubjectAccessReviewSpec) (bool, string) {
+		return spec.User == "jane" && spec.ResourceAttributes.Verb == "get", "jane may get"
+	}
+	sar, err := c.CreateSubjectAccessReview(k8s.NewSubjectAccessReview("jane", nil, k8s.ResourceAttributes{Verb: "get", Resource: "pods"}))
+	require.Nil(t, err)
+	assert.True(t, sar.Status.Allowed)
+	sar, err = c.CreateSubjectAccessReview(k8s.NewSubjectAccessReview("jane", nil, k8s.ResourceAttributes{Verb: "delete", Resource: "pods"}))
+	require.Nil(t, err)
+	assert.False(t, sar.Status.Allowed)
+
+	tr, err := c.CreateTokenReview(k8s.NewTokenReview("secret"))
+	require.Nil(t, err)
+	assert.False(t, tr.Status.Authenticated, "no token is authenticated by default")
+
+	c.Authenticate = func(spec k8s.TokenReviewSpec) (*k8s.UserInfo, bool) {
+		return &k8s.UserInfo{Username: "jane"}, spec.Token == "secret"
+	}
+	tr, err = c.CreateTokenReview(k8s.NewTokenReview("secret", "api"))
+	require.Nil(t, err)
+	assert.True(t, tr.Status.Authenticated)
+	assert.Equal(t, "jane", tr.Status.User.Username)
+	assert.Equal(t, []string{"api"}, tr.Status.Audiences)
+}
+
+func TestDynamicClusterScoped(t *testing.T) {
+	c, err := New(&k8s.Node{ObjectMeta: k8s.NewObjectMeta("", "node-1")})
+	require.Nil(t, err)
+
+	nodes := c.Resource(k8s.GroupVersionResource{Version: "v1", Resource: "nodes"})
+	node, err := nodes.Get("default", "node-1")
+	require.Nil(t, err, "the namespace is ignored for cluster scoped resources")
+	assert.Equal(t, "", node.GetNamespace())
+
+	item := k8s.NewUnstructured("v1", "Node", "", "node-2")
+	_, err = nodes.Create("default", item)
+	require.Nil(t, err)
+	_, err = c.GetNode("node-2")
+	assert.Nil(t, err)
+
+	_, err = c.Resource(k8s.GroupVersionResource{Version: "v1", Resource: "widgets"}).Get("default", "a")
+	assert.True(t, k8s.IsNotFoundError(err))
+}
@@ -0,0 +1,548 @@
+package fake
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+type (
+	// tracker stores objects as decoded JSON, keyed by group and resource,
+	// and keeps a history of changes to serve watches.
+	tracker struct {
+		sync.Mutex
+		resourceVersion int64
+		// objects maps a resource key to the objects of that resource by
+		// namespace/name.
+		objects map[string]map[string]map[string]interface{}
+		// hasStatus is set for resource keys with a status subresource.
+		hasStatus map[string]bool
+		history   []change
+		watchers  map[*watcher]struct{}
+	}
+
+	change struct {
+		resource  string
+		eventType k8s.WatchEventType
+		object    map[string]interface{}
+		version   int64
+	}
+
+	watcher struct {
+		resource  string
+		namespace string
+		opts      *k8s.ListOptions
+		queue     []k8s.WatchEvent
+		wake      chan struct{}
+		stop      chan struct{}
+	}
+)
+
+func newTracker() *tracker {
+	return &tracker{
+		objects:   make(map[string]map[string]map[string]interface{}),
+		hasStatus: make(map[string]bool),
+		watchers:  make(map[*watcher]struct{}),
+	}
+}
+
+func resourceKey(resource k8s.GroupVersionResource) string {
+	return resource.Group + "/" + resource.Resource
+}
+
+func objectKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+func newStatus(code int, reason k8s.StatusReason, format string, args ...interface{}) *k8s.Status {
+	return &k8s.Status{
+		TypeMeta: k8s.NewTypeMeta("Status", "v1"),
+		Status:   k8s.StatusFailure,
+		Message:  fmt.Sprintf(format, args...),
+		Reason:   reason,
+		Code:     int32(code),
+	}
+}
+
+func notFound(resource k8s.GroupVersionResource, name string) *k8s.Status {
+	return newStatus(http.StatusNotFound, "NotFound", "%s %q not found", resource.Resource, name)
+}
+
+func (t *tracker) get(resource k8s.GroupVersionResource, namespace, name string, out interface{}) error {
+	t.Lock()
+	defer t.Unlock()
+
+	obj, ok := t.objects[resourceKey(resource)][objectKey(namespace, name)]
+	if !ok {
+		return notFound(resource, name)
+	}
+	return convert(obj, out)
+}
+
+func (t *tracker) list(resource k8s.GroupVersionResource, namespace string, opts *k8s.ListOptions, out interface{}) error {
+	t.Lock()
+	defer t.Unlock()
+
+	objects := t.objects[resourceKey(resource)]
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	items := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		obj := objects[key]
+		if matches(obj, namespace, opts) {
+			items = append(items, obj)
+		}
+	}
+	list := map[string]interface{}{
+		"apiVersion": resource.GroupVersion(),
+		"kind":       "List",
+		"metadata": map[string]interface{}{
+			"resourceVersion": strconv.FormatInt(t.resourceVersion, 10),
+		},
+		"items": items,
+	}
+	return convert(list, out)
+}
+
+func (t *tracker) create(resource k8s.GroupVersionResource, namespace string, item, out interface{}) error {
+	obj, err := toObject(item)
+	if err != nil {
+		return err
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	metadata := nestedMap(obj, "metadata")
+	name, _ := metadata["name"].(string)
+	if name == "" {
+		if prefix, _ := metadata["generateName"].(string); prefix != "" {
+			name = prefix + randomString(5)
+			metadata["name"] = name
+		}
+	}
+	if name == "" {
+		return newStatus(http.StatusUnprocessableEntity, "Invalid", "%s must have a name", resource.Resource)
+	}
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+
+	key := resourceKey(resource)
+	if _, ok := t.objects[key][objectKey(namespace, name)]; ok {
+		return newStatus(http.StatusConflict, "AlreadyExists", "%s %q already exists", resource.Resource, name)
+	}
+
+	metadata["uid"] = newUID()
+	metadata["creationTimestamp"] = time.Now().UTC().Format(time.RFC3339)
+	metadata["generation"] = json.Number("1")
+	delete(metadata, "deletionTimestamp")
+
+	return convert(t.store(key, namespace, name, k8s.WatchEventTypeAdded, obj), out)
+}
+
+// update replaces an object. If subresource is "status", only the status of
+// the object is changed. Otherwise the status is kept for resources that have
+// a status subresource, as the API server does.
+func (t *tracker) update(resource k8s.GroupVersionResource, namespace string, item, out interface{}, subresource string) error {
+	obj, err := toObject(item)
+	if err != nil {
+		return err
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	return t.replace(resource, namespace, obj, out, subresource)
+}
+
+// patch applies a merge patch to an object.
+func (t *tracker) patch(resource k8s.GroupVersionResource, namespace, name string, pt k8s.PatchType, data []byte, out interface{}) error {
+	switch pt {
+	case k8s.MergePatchType, k8s.StrategicMergePatchType:
+	default:
+		return newStatus(http.StatusUnsupportedMediaType, "UnsupportedMediaType", "patch type %s is not supported", pt)
+	}
+	patch, err := decode(data)
+	if err != nil {
+		return newStatus(http.StatusBadRequest, "BadRequest", "invalid patch: %s", err)
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	existing, ok := t.objects[resourceKey(resource)][objectKey(namespace, name)]
+	if !ok {
+		return notFound(resource, name)
+	}
+	patched, ok := mergePatch(deepCopy(existing), patch).(map[string]interface{})
+	if !ok {
+		return newStatus(http.StatusBadRequest, "BadRequest", "patch must be an object")
+	}
+	return t.replace(resource, namespace, patched, out, "")
+}
+
+// replace must be called with the lock held.
+func (t *tracker) replace(resource k8s.GroupVersionResource, namespace string, obj map[string]interface{}, out interface{}, subresource string) error {
+	key := resourceKey(resource)
+	metadata := nestedMap(obj, "metadata")
+	name, _ := metadata["name"].(string)
+
+	existing, ok := t.objects[key][objectKey(namespace, name)]
+	if !ok {
+		return notFound(resource, name)
+	}
+	existingMetadata := nestedMap(existing, "metadata")
+	if rv, _ := metadata["resourceVersion"].(string); rv != "" && rv != existingMetadata["resourceVersion"] {
+		return newStatus(http.StatusConflict, "Conflict",
+			"Operation cannot be fulfilled on %s %q: the object has been modified; please apply your changes to the latest version and try again",
+			resource.Resource, name)
+	}
+
+	switch {
+	case subresource == "status":
+		status, hasStatus := obj["status"]
+		obj = deepCopy(existing).(map[string]interface{})
+		metadata = nestedMap(obj, "metadata")
+		delete(obj, "status")
+		if hasStatus {
+			obj["status"] = status
+		}
+	case t.hasStatus[key]:
+		delete(obj, "status")
+		if status, ok := existing["status"]; ok {
+			obj["status"] = deepCopy(status)
+		}
+	}
+
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	}
+	for _, field := range []string{"uid", "creationTimestamp", "generation"} {
+		if value, ok := existingMetadata[field]; ok {
+			metadata[field] = value
+		}
+	}
+	if !reflect.DeepEqual(obj["spec"], existing["spec"]) {
+		generation, _ := strconv.ParseInt(fmt.Sprint(existingMetadata["generation"]), 10, 64)
+		metadata["generation"] = json.Number(strconv.FormatInt(generation+1, 10))
+	}
+
+	return convert(t.store(key, namespace, name, k8s.WatchEventTypeModified, obj), out)
+}
+
+func (t *tracker) delete(resource k8s.GroupVersionResource, namespace, name string) error {
+	t.Lock()
+	defer t.Unlock()
+
+	key := resourceKey(resource)
+	obj, ok := t.objects[key][objectKey(namespace, name)]
+	if !ok {
+		return notFound(resource, name)
+	}
+	t.store(key, namespace, name, k8s.WatchEventTypeDeleted, obj)
+	return nil
+}
+
+// store saves the change and tells any watchers about it. It returns the
+// object as stored, with its new resource version. It must be called with the
+// lock held.
+func (t *tracker) store(key, namespace, name string, eventType k8s.WatchEventType, obj map[string]interface{}) map[string]interface{} {
+	t.resourceVersion++
+	obj = deepCopy(obj).(map[string]interface{})
+	nestedMap(obj, "metadata")["resourceVersion"] = strconv.FormatInt(t.resourceVersion, 10)
+
+	if eventType == k8s.WatchEventTypeDeleted {
+		delete(t.objects[key], objectKey(namespace, name))
+	} else {
+		if t.objects[key] == nil {
+			t.objects[key] = make(map[string]map[string]interface{})
+		}
+		t.objects[key][objectKey(namespace, name)] = obj
+	}
+
+	c := change{resource: key, eventType: eventType, object: obj, version: t.resourceVersion}
+	t.history = append(t.history, c)
+	for w := range t.watchers {
+		w.send(c)
+	}
+	return obj
+}
+
+// watch sends changes to the resource into events until the timeout in opts
+// passes or stopWatches is called. It closes events before returning. If
+// opts has no resource version, the watch starts with an ADDED event for each
+// existing object.
+func (t *tracker) watch(resource k8s.GroupVersionResource, namespace string, opts *k8s.WatchOptions, events chan k8s.WatchEvent) error {
+	defer close(events)
+	if opts == nil {
+		opts = &k8s.WatchOptions{}
+	}
+
+	w := &watcher{
+		resource:  resourceKey(resource),
+		namespace: namespace,
+		opts:      &opts.ListOptions,
+		wake:      make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+	}
+
+	t.Lock()
+	if opts.ResourceVersion == "" {
+		objects := t.objects[w.resource]
+		keys := make([]string, 0, len(objects))
+		for key := range objects {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			w.send(change{resource: w.resource, eventType: k8s.WatchEventTypeAdded, object: objects[key]})
+		}
+	} else {
+		since, err := strconv.ParseInt(opts.ResourceVersion, 10, 64)
+		if err != nil {
+			t.Unlock()
+			return newStatus(http.StatusBadRequest, "BadRequest", "invalid resource version %q", opts.ResourceVersion)
+		}
+		for _, c := range t.history {
+			if c.version > since {
+				w.send(c)
+			}
+		}
+	}
+	t.watchers[w] = struct{}{}
+	t.Unlock()
+
+	defer func() {
+		t.Lock()
+		delete(t.watchers, w)
+		t.Unlock()
+	}()
+
+	var timeout <-chan time.Time
+	if opts.TimeoutSeconds > 0 {
+		timer := time.NewTimer(time.Duration(opts.TimeoutSeconds) * time.Second)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	for {
+		t.Lock()
+		queue := w.queue
+		w.queue = nil
+		t.Unlock()
+
+		for _, ev := range queue {
+			select {
+			case events <- ev:
+			case <-w.stop:
+				return nil
+			case <-timeout:
+				return nil
+			}
+		}
+
+		select {
+		case <-w.wake:
+		case <-w.stop:
+			return nil
+		case <-timeout:
+			return nil
+		}
+	}
+}
+
+// stopWatches ends all running watches.
+func (t *tracker) stopWatches() {
+	t.Lock()
+	defer t.Unlock()
+	for w := range t.watchers {
+		close(w.stop)
+		delete(t.watchers, w)
+	}
+}
+
+// send queues the change if the watcher is interested in it. It must be
+// called with the tracker locked.
+func (w *watcher) send(c change) {
+	if c.resource != w.resource || !matches(c.object, w.namespace, w.opts) {
+		return
+	}
+	data, err := json.Marshal(c.object)
+	if err != nil {
+		// objects are decoded JSON, so this cannot happen
+		panic(err)
+	}
+	w.queue = append(w.queue, k8s.WatchEvent{Type: c.eventType, Object: data})
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// matches reports whether the object is in the namespace, if not empty, and
+// matches the selectors in opts. Field selectors are dotted paths into the
+// object, such as "metadata.name" or "spec.nodeName".
+func matches(obj map[string]interface{}, namespace string, opts *k8s.ListOptions) bool {
+	metadata := nestedMap(obj, "metadata")
+	if namespace != "" && metadata["namespace"] != namespace {
+		return false
+	}
+	if opts == nil {
+		return true
+	}
+
+	labels := make(map[string]string)
+	for k, v := range nestedMap(obj, "metadata", "labels") {
+		if value, ok := v.(string); ok {
+			labels[k] = value
+		}
+	}
+	if !opts.LabelSelector.Matches(labels) {
+		return false
+	}
+	for path, v := range opts.FieldSelector {
+		var value interface{} = obj
+		for _, field := range strings.Split(path, ".") {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				value = nil
+				break
+			}
+			value = m[field]
+		}
+		if value == nil {
+			value = ""
+		}
+		if fmt.Sprint(value) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// mergePatch applies a JSON merge patch, as described in RFC 7386.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{})
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergePatch(t[k], v)
+	}
+	return t
+}
+
+// nestedMap returns the map at the path in obj, creating it if needed.
+func nestedMap(obj map[string]interface{}, fields ...string) map[string]interface{} {
+	for _, field := range fields {
+		m, ok := obj[field].(map[string]interface{})
+		if !ok {
+			m = make(map[string]interface{})
+			obj[field] = m
+		}
+		obj = m
+	}
+	return obj
+}
+
+// toObject converts an item to decoded JSON.
+func toObject(item interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode object")
+	}
+	value, err := decode(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode object")
+	}
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("object must be a JSON object")
+	}
+	return obj, nil
+}
+
+func decode(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// convert copies decoded JSON into out. out may be nil.
+func convert(in, out interface{}) error {
+	if out == nil {
+		return nil
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode object")
+	}
+	return errors.Wrap(json.Unmarshal(data, out), "failed to decode object")
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			out[k] = deepCopy(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = deepCopy(item)
+		}
+		return out
+	}
+	return value
+}
+
+func newUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func randomString(n int) string {
+	const letters = "bcdfghjklmnpqrstvwxz2456789"
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	for i := range b {
+		b[i] = letters[int(b[i])%len(letters)]
+	}
+	return string(b)
+}
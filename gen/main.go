@@ -0,0 +1,260 @@
+// Command gen generates the typed clients for the kinds listed in kinds.json.
+//
+// For each kind it writes:
+//
+//   - the XInterface, XWatchEvent and XListWatcher definitions to the root package
+//   - the http client to http/x.go
+//   - the in-memory client to the fake package, along with tests for it
+//
+// The Go types for most kinds are written by hand in the root package. Kinds
+// that list "definitions" have them generated to zz_generated_types.go by
+// gen/openapi instead, from the pinned OpenAPI document in gen/swagger.json
+// or the one given with -spec.
+// Methods that do not follow the usual pattern, such as EvictPod, are declared
+// in a hand-written XExpansion interface when the kind sets "expansion".
+//
+// It is run from the root of the repository:
+//
+//	go run ./gen
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/bakins/k8s-client/gen/openapi"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Kind is an entry in kinds.json.
+	Kind struct {
+		// Kind is the name of the Go type in the root package, such as "Pod".
+		Kind string `json:"kind"`
+		// Plural is the name of the resource, such as "pods".
+		Plural string `json:"plural"`
+		// PluralKind is used in method names, such as ListPods. It defaults to
+		// Kind followed by whatever Plural adds to the lower case kind.
+		PluralKind string `json:"pluralKind,omitempty"`
+		// GroupVersions are the group versions the kind is served at, in
+		// order of preference. The client uses the first one the server
+		// supports.
+		GroupVersions []string `json:"groupVersions"`
+		// Namespaced is false for cluster scoped kinds such as Node.
+		Namespaced bool `json:"namespaced"`
+		// Subresources of the kind. An UpdateXStatus method is generated for
+		// "status". Others are only advertised by the fake discovery and
+		// are expected to be implemented in the expansion.
+		Subresources []string `json:"subresources,omitempty"`
+		// Expansion embeds the hand-written XExpansion interface in XInterface.
+		Expansion bool `json:"expansion,omitempty"`
+		// Definitions are the OpenAPI definitions to generate the Go types
+		// for the kind from, such as "io.k8s.api.scheduling.v1.PriorityClass".
+		Definitions []string `json:"definitions,omitempty"`
+	}
+)
+
+func main() {
+	kindsFile := flag.String("kinds", "gen/kinds.json", "file listing the kinds to generate")
+	root := flag.String("root", ".", "root of the repository")
+	spec := flag.String("spec", "gen/swagger.json", "OpenAPI document to generate types from, as a file or URL")
+	flag.Parse()
+
+	if err := run(*kindsFile, *root, *spec); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(kindsFile, root, spec string) error {
+	kinds, err := readKinds(kindsFile)
+	if err != nil {
+		return err
+	}
+
+	files := map[string]*template.Template{
+		"zz_generated.go":           rootTemplate,
+		"fake/zz_generated.go":      fakeTemplate,
+		"fake/zz_generated_test.go": fakeTestTemplate,
+	}
+	for name, tmpl := range files {
+		if err := generate(filepath.Join(root, name), tmpl, kinds); err != nil {
+			return err
+		}
+	}
+	for _, k := range kinds {
+		if err := generate(filepath.Join(root, "http", k.Lower()+".go"), httpTemplate, k); err != nil {
+			return err
+		}
+	}
+
+	var definitions []string
+	for _, k := range kinds {
+		definitions = append(definitions, k.Definitions...)
+	}
+	if len(definitions) == 0 {
+		return nil
+	}
+	return errors.Wrap(openapi.Run(spec, root, "zz_generated_types.go", "client", definitions), "failed to generate types")
+}
+
+func readKinds(path string) ([]Kind, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read kinds")
+	}
+	var kinds []Kind
+	if err := json.Unmarshal(data, &kinds); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", path)
+	}
+
+	seen := make(map[string]bool)
+	for i := range kinds {
+		k := &kinds[i]
+		if k.Kind == "" || k.Plural == "" || len(k.GroupVersions) == 0 {
+			return nil, errors.Errorf("kind %d in %s must have kind, plural and groupVersions", i, path)
+		}
+		if seen[k.Kind] {
+			return nil, errors.Errorf("kind %s is listed more than once", k.Kind)
+		}
+		seen[k.Kind] = true
+
+		if k.PluralKind == "" {
+			if !strings.HasPrefix(k.Plural, k.Lower()) {
+				return nil, errors.Errorf("kind %s must set pluralKind", k.Kind)
+			}
+			k.PluralKind = k.Kind + strings.TrimPrefix(k.Plural, k.Lower())
+		}
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i].Kind < kinds[j].Kind
+	})
+	return kinds, nil
+}
+
+func generate(path string, tmpl *template.Template, data interface{}) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return errors.Wrapf(err, "failed to generate %s", path)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		// write it out anyway to make it easier to find the problem
+		_ = ioutil.WriteFile(path, buf.Bytes(), 0644)
+		return errors.Wrapf(err, "failed to format %s", path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, src, 0644)
+}
+
+// Lower is the lower case kind, used to name files and unexported identifiers.
+func (k Kind) Lower() string {
+	return strings.ToLower(k.Kind)
+}
+
+// Group is the group of the preferred group version.
+func (k Kind) Group() string {
+	group, _ := splitGroupVersion(k.GroupVersions[0])
+	return group
+}
+
+// Version is the version of the preferred group version.
+func (k Kind) Version() string {
+	_, version := splitGroupVersion(k.GroupVersions[0])
+	return version
+}
+
+// GroupOf is the group of one of the kind's group versions.
+func (k Kind) GroupOf(groupVersion string) string {
+	group, _ := splitGroupVersion(groupVersion)
+	return group
+}
+
+// VersionOf is the version of one of the kind's group versions.
+func (k Kind) VersionOf(groupVersion string) string {
+	_, version := splitGroupVersion(groupVersion)
+	return version
+}
+
+// HasStatus reports whether an UpdateXStatus method is generated.
+func (k Kind) HasStatus() bool {
+	return k.HasSubresource("status")
+}
+
+// HasSubresource reports whether the kind has the subresource.
+func (k Kind) HasSubresource(name string) bool {
+	for _, s := range k.Subresources {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupVersionsLiteral is GroupVersions as a Go []string literal.
+func (k Kind) GroupVersionsLiteral() string {
+	return stringsLiteral(k.GroupVersions)
+}
+
+// SubresourcesLiteral is Subresources as a Go []string literal.
+func (k Kind) SubresourcesLiteral() string {
+	return stringsLiteral(k.Subresources)
+}
+
+// NsParam is the namespace parameter of methods that take an item.
+func (k Kind) NsParam() string {
+	if k.Namespaced {
+		return "namespace string, "
+	}
+	return ""
+}
+
+// NsNameParams are the parameters of methods that take a name.
+func (k Kind) NsNameParams() string {
+	if k.Namespaced {
+		return "namespace, name string"
+	}
+	return "name string"
+}
+
+// NsArg passes the namespace, if any, to another function.
+func (k Kind) NsArg() string {
+	if k.Namespaced {
+		return "namespace, "
+	}
+	return ""
+}
+
+// NsValue is the namespace as an expression, which is "" for cluster scoped kinds.
+func (k Kind) NsValue() string {
+	if k.Namespaced {
+		return "namespace"
+	}
+	return `""`
+}
+
+func stringsLiteral(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+func splitGroupVersion(gv string) (string, string) {
+	if i := strings.Index(gv, "/"); i >= 0 {
+		return gv[:i], gv[i+1:]
+	}
+	return "", gv
+}
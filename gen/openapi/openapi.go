@@ -0,0 +1,558 @@
+// Package openapi generates Go types in the root package from the OpenAPI
+// document of a Kubernetes API server. It is run by gen for the kinds in
+// kinds.json that list "definitions".
+//
+// The document may be OpenAPI v2, as served at /openapi/v2, or v3, as served
+// at /openapi/v3/apis/GROUP/VERSION. It is read from a file, such as the
+// pinned gen/swagger.json, or fetched from a URL, such as one served by
+// kubectl proxy.
+//
+// Definitions are named in full, such as io.k8s.api.apps.v1.StatefulSet.
+// Definitions they refer to are generated too. Each is named after the last
+// part of its name, so io.k8s.api.apps.v1.StatefulSetSpec becomes
+// StatefulSetSpec. If the
+// package already declares a type of that name, outside of the output file,
+// the declared type is used instead. This is how hand-written types such as
+// ObjectMeta, Time and Quantity take the place of the generated ones.
+//
+// Required fields are values. Optional fields are tagged omitempty, and are
+// pointers if they are structs, integers or booleans so that unset can be
+// told apart from the zero value. Kinds embed TypeMeta and ObjectMeta or
+// ListMeta, and keep fields they do not model when re-encoded, like the
+// hand-written kinds.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// Document is an OpenAPI v2 or v3 document. Only the schemas are used.
+	Document struct {
+		Definitions map[string]*Schema `json:"definitions"`
+		Components  struct {
+			Schemas map[string]*Schema `json:"schemas"`
+		} `json:"components"`
+	}
+
+	// Schema is the subset of an OpenAPI schema that describes Kubernetes types.
+	Schema struct {
+		Ref                  string             `json:"$ref"`
+		Type                 string             `json:"type"`
+		Format               string             `json:"format"`
+		Description          string             `json:"description"`
+		Properties           map[string]*Schema `json:"properties"`
+		Required             []string           `json:"required"`
+		Items                *Schema            `json:"items"`
+		AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+		AllOf                []*Schema          `json:"allOf"`
+		IntOrString          bool               `json:"x-kubernetes-int-or-string"`
+		PreserveUnknown      bool               `json:"x-kubernetes-preserve-unknown-fields"`
+	}
+
+	generator struct {
+		schemas map[string]*Schema
+		// declared are the types the package already declares.
+		declared map[string]bool
+		// names maps the definitions seen so far to their Go names.
+		names   map[string]string
+		queue   []string
+		imports map[string]bool
+		buf     bytes.Buffer
+	}
+)
+
+// builtin maps definitions with no struct of their own to Go types.
+var builtin = map[string]string{
+	"io.k8s.apimachinery.pkg.apis.meta.v1.MicroTime":                                  "Time",
+	"io.k8s.apimachinery.pkg.runtime.RawExtension":                                    "json.RawMessage",
+	"io.k8s.apiextensions-apiserver.pkg.apis.apiextensions.v1.JSON":                   "json.RawMessage",
+	"io.k8s.apiextensions-apiserver.pkg.apis.apiextensions.v1beta1.JSON":              "json.RawMessage",
+	"io.k8s.apimachinery.pkg.apis.meta.v1.FieldsV1":                                   "json.RawMessage",
+	"io.k8s.apiextensions-apiserver.pkg.apis.apiextensions.v1.JSONSchemaPropsOrArray": "json.RawMessage",
+	"io.k8s.apiextensions-apiserver.pkg.apis.apiextensions.v1.JSONSchemaPropsOrBool":  "json.RawMessage",
+}
+
+// initialisms are field names that are upper case in Go.
+var initialisms = map[string]string{
+	"uid": "UID",
+	"ip":  "IP",
+	"ips": "IPs",
+	"url": "URL",
+	"id":  "ID",
+}
+
+// Run generates the definitions from the OpenAPI document spec, a file or
+// URL, into the file out of the package pkg in dir.
+func Run(spec, dir, out, pkg string, definitions []string) error {
+	data, err := readSpec(spec)
+	if err != nil {
+		return err
+	}
+	schemas, err := parseDocument(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse %s", spec)
+	}
+	declared, err := declaredTypes(dir, out)
+	if err != nil {
+		return err
+	}
+
+	source := spec
+	if !isURL(spec) {
+		source = filepath.Base(spec)
+	}
+	src, err := generate(schemas, declared, pkg, source, definitions)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, out), src, 0644)
+}
+
+func readSpec(spec string) ([]byte, error) {
+	if !isURL(spec) {
+		data, err := ioutil.ReadFile(spec)
+		return data, errors.Wrap(err, "failed to read OpenAPI document")
+	}
+
+	resp, err := http.Get(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch OpenAPI document")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch OpenAPI document: %s", resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	return data, errors.Wrap(err, "failed to fetch OpenAPI document")
+}
+
+func isURL(spec string) bool {
+	return strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://")
+}
+
+func parseDocument(data []byte) (map[string]*Schema, error) {
+	var doc Document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	schemas := doc.Definitions
+	if len(schemas) == 0 {
+		schemas = doc.Components.Schemas
+	}
+	if len(schemas) == 0 {
+		return nil, errors.New("document has no definitions")
+	}
+	return schemas, nil
+}
+
+// declaredTypes returns the types declared in the package in dir, other than
+// in the file that is being generated.
+func declaredTypes(dir, out string) (map[string]bool, error) {
+	filter := func(info os.FileInfo) bool {
+		name := info.Name()
+		return name != filepath.Base(out) && !strings.HasSuffix(name, "_test.go")
+	}
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, filter, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse package in %s", dir)
+	}
+	declared := make(map[string]bool)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					declared[spec.(*ast.TypeSpec).Name.Name] = true
+				}
+			}
+		}
+	}
+	return declared, nil
+}
+
+// generate returns the source of the types for the definitions.
+func generate(schemas map[string]*Schema, declared map[string]bool, pkg, source string, definitions []string) ([]byte, error) {
+	g := &generator{
+		schemas:  schemas,
+		declared: declared,
+		names:    make(map[string]string),
+		imports:  make(map[string]bool),
+	}
+	for _, name := range definitions {
+		if _, ok := schemas[name]; !ok {
+			return nil, errors.Errorf("no definition %s", name)
+		}
+		if declared[shortName(name)] {
+			return nil, errors.Errorf("%s is already declared in the package", shortName(name))
+		}
+		if _, err := g.typeName(name); err != nil {
+			return nil, err
+		}
+	}
+
+	var body bytes.Buffer
+	for len(g.queue) > 0 {
+		name := g.queue[0]
+		g.queue = g.queue[1:]
+		g.buf.Reset()
+		if err := g.definition(name); err != nil {
+			return nil, errors.Wrapf(err, "failed to generate %s", name)
+		}
+		body.Write(g.buf.Bytes())
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen/openapi from %s. DO NOT EDIT.\n\npackage %s\n\n", source, pkg)
+	if g.imports["encoding/json"] {
+		buf.WriteString("import \"encoding/json\"\n\n")
+	}
+	buf.Write(body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to format generated types")
+	}
+	return src, nil
+}
+
+// typeName returns the Go type for a definition, queueing it to be
+// generated if needed.
+func (g *generator) typeName(definition string) (string, error) {
+	if name, ok := g.names[definition]; ok {
+		return name, nil
+	}
+	if name, ok := builtin[definition]; ok {
+		if strings.HasPrefix(name, "json.") {
+			g.imports["encoding/json"] = true
+		}
+		g.names[definition] = name
+		return name, nil
+	}
+
+	name := shortName(definition)
+	for other, otherName := range g.names {
+		if otherName == name && !g.declared[name] {
+			return "", errors.Errorf("%s and %s would both be named %s; generate them separately", other, definition, name)
+		}
+	}
+	g.names[definition] = name
+	if !g.declared[name] {
+		if _, ok := g.schemas[definition]; !ok {
+			return "", errors.Errorf("no definition %s", definition)
+		}
+		g.queue = append(g.queue, definition)
+	}
+	return name, nil
+}
+
+func (g *generator) definition(definition string) error {
+	name := g.names[definition]
+	s := g.schemas[definition]
+
+	writeComment(&g.buf, "", s.Description)
+	if len(s.Properties) == 0 {
+		typ, err := g.goType(s, true)
+		if err != nil {
+			return err
+		}
+		if typ == "map[string]interface{}" || typ == "struct{}" {
+			typ = "json.RawMessage"
+			g.imports["encoding/json"] = true
+		}
+		fmt.Fprintf(&g.buf, "type %s %s\n\n", name, typ)
+		return nil
+	}
+
+	required := make(map[string]bool)
+	for _, r := range s.Required {
+		required[r] = true
+	}
+
+	isKind := s.Properties["apiVersion"] != nil && s.Properties["kind"] != nil
+	fmt.Fprintf(&g.buf, "type %s struct {\n", name)
+	if isKind {
+		g.buf.WriteString("TypeMeta `json:\",inline\"`\n")
+	}
+	for _, prop := range sortedProperties(s.Properties) {
+		p := s.Properties[prop]
+		if isKind && (prop == "apiVersion" || prop == "kind") {
+			continue
+		}
+		if prop == "metadata" {
+			if meta := g.refName(p); meta == "ObjectMeta" || meta == "ListMeta" {
+				fmt.Fprintf(&g.buf, "%s `json:\"metadata,omitempty\"`\n", meta)
+				continue
+			}
+		}
+
+		typ, err := g.goType(p, required[prop])
+		if err != nil {
+			return errors.Wrapf(err, "field %s", prop)
+		}
+		tag := prop
+		if !required[prop] {
+			tag += ",omitempty"
+		}
+		if p.description() != "" {
+			g.buf.WriteString("\n")
+			writeComment(&g.buf, "", p.description())
+		}
+		fmt.Fprintf(&g.buf, "%s %s `json:%q`\n", fieldName(prop), typ, tag)
+	}
+	if isKind {
+		g.buf.WriteString("\n// raw is the JSON the object was decoded from.\nraw unknownFields\n")
+	}
+	g.buf.WriteString("}\n\n")
+
+	if isKind {
+		recv := strings.ToLower(name[:1])
+		fmt.Fprintf(&g.buf, `// UnmarshalJSON decodes the %[1]s, keeping any fields this package does not
+// model so that they are not lost when it is encoded again.
+func (%[2]s *%[1]s) UnmarshalJSON(data []byte) error {
+	type alias %[1]s
+	return unmarshalKeepingUnknown(data, (*alias)(%[2]s), &%[2]s.raw)
+}
+
+// MarshalJSON encodes the %[1]s, including any fields it was decoded with
+// that this package does not model.
+func (%[2]s %[1]s) MarshalJSON() ([]byte, error) {
+	type alias %[1]s
+	return marshalKeepingUnknown(alias(%[2]s), %[2]s.raw)
+}
+
+`, name, recv)
+	}
+	return nil
+}
+
+// goType returns the Go type of a field.
+func (g *generator) goType(s *Schema, required bool) (string, error) {
+	if ref := s.ref(); ref != "" {
+		name, err := g.typeName(ref)
+		if err != nil {
+			return "", err
+		}
+		if !required && g.isStruct(ref) {
+			return "*" + name, nil
+		}
+		return name, nil
+	}
+
+	optional := func(typ string) string {
+		if required {
+			return typ
+		}
+		return "*" + typ
+	}
+
+	switch {
+	case s.IntOrString:
+		return optional("IntOrString"), nil
+	case s.PreserveUnknown && len(s.Properties) == 0:
+		g.imports["encoding/json"] = true
+		return "json.RawMessage", nil
+	}
+
+	switch s.Type {
+	case "string":
+		if s.Format == "byte" {
+			return "[]byte", nil
+		}
+		if s.Format == "date-time" {
+			return optional("Time"), nil
+		}
+		return "string", nil
+	case "integer":
+		if s.Format == "int64" {
+			return optional("int64"), nil
+		}
+		return optional("int32"), nil
+	case "number":
+		return optional("float64"), nil
+	case "boolean":
+		return optional("bool"), nil
+	case "array":
+		if s.Items == nil {
+			return "[]interface{}", nil
+		}
+		elem, err := g.goType(s.Items, true)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object", "":
+		additional, err := s.additionalProperties()
+		if err != nil {
+			return "", err
+		}
+		if additional != nil {
+			elem, err := g.goType(additional, true)
+			if err != nil {
+				return "", err
+			}
+			return "map[string]" + elem, nil
+		}
+		if len(s.Properties) > 0 {
+			return "", errors.New("inline objects are not supported")
+		}
+		return "map[string]interface{}", nil
+	}
+	return "", errors.Errorf("unsupported type %q", s.Type)
+}
+
+// isStruct reports whether the definition is generated as, or declared as,
+// a struct.
+func (g *generator) isStruct(definition string) bool {
+	if _, ok := builtin[definition]; ok {
+		return false
+	}
+	s, ok := g.schemas[definition]
+	if !ok {
+		return false
+	}
+	if len(s.Properties) > 0 {
+		return true
+	}
+	// hand-written types for definitions that are strings in JSON, such as
+	// Time and IntOrString, are structs too.
+	return g.declared[shortName(definition)] && (s.Format == "date-time" || s.Format == "int-or-string")
+}
+
+// refName returns the Go name of the definition a field refers to, if any.
+func (g *generator) refName(s *Schema) string {
+	if ref := s.ref(); ref != "" {
+		name, _ := g.typeName(ref)
+		return name
+	}
+	return ""
+}
+
+// ref returns the definition the schema refers to, directly or as the only
+// entry of allOf as OpenAPI v3 does.
+func (s *Schema) ref() string {
+	ref := s.Ref
+	if ref == "" && len(s.AllOf) == 1 {
+		ref = s.AllOf[0].Ref
+	}
+	ref = strings.TrimPrefix(ref, "#/definitions/")
+	return strings.TrimPrefix(ref, "#/components/schemas/")
+}
+
+// description of a field, falling back to that of the definition it refers to.
+func (s *Schema) description() string {
+	if s.Description == "" && len(s.AllOf) == 1 {
+		return s.AllOf[0].Description
+	}
+	return s.Description
+}
+
+func (s *Schema) additionalProperties() (*Schema, error) {
+	if len(s.AdditionalProperties) == 0 || string(s.AdditionalProperties) == "false" {
+		return nil, nil
+	}
+	if string(s.AdditionalProperties) == "true" {
+		return &Schema{}, nil
+	}
+	var additional Schema
+	if err := json.Unmarshal(s.AdditionalProperties, &additional); err != nil {
+		return nil, errors.Wrap(err, "invalid additionalProperties")
+	}
+	return &additional, nil
+}
+
+// shortName is the last part of a definition name.
+func shortName(definition string) string {
+	return definition[strings.LastIndex(definition, ".")+1:]
+}
+
+// sortedProperties puts the usual top level fields first and the rest in
+// alphabetical order.
+func sortedProperties(properties map[string]*Schema) []string {
+	order := map[string]int{"apiVersion": 1, "kind": 2, "metadata": 3, "spec": 4, "status": 5}
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		oi, oj := order[names[i]], order[names[j]]
+		if oi == 0 {
+			oi = len(order) + 1
+		}
+		if oj == 0 {
+			oj = len(order) + 1
+		}
+		if oi != oj {
+			return oi < oj
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// fieldName converts a JSON field name, such as "podIP" or "x-kubernetes-embedded-resource",
+// to an exported Go name.
+func fieldName(name string) string {
+	if upper, ok := initialisms[name]; ok {
+		return upper
+	}
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var out strings.Builder
+	for _, part := range parts {
+		if upper, ok := initialisms[part]; ok {
+			out.WriteString(upper)
+			continue
+		}
+		out.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	if out.Len() == 0 || unicode.IsDigit(rune(out.String()[0])) {
+		return "Field" + out.String()
+	}
+	return out.String()
+}
+
+// writeComment writes text as a comment wrapped at about 80 columns.
+func writeComment(buf *bytes.Buffer, indent, text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	for _, para := range strings.Split(text, "\n") {
+		words := strings.Fields(para)
+		if len(words) == 0 {
+			buf.WriteString(indent + "//\n")
+			continue
+		}
+		line := indent + "//"
+		for _, word := range words {
+			if len(line)+1+len(word) > 80 && len(line) > len(indent)+2 {
+				buf.WriteString(line + "\n")
+				line = indent + "//"
+			}
+			line += " " + word
+		}
+		buf.WriteString(line + "\n")
+	}
+}
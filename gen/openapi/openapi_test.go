@@ -0,0 +1,166 @@
+package openapi
+
+import (
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `{
+  "swagger": "2.0",
+  "definitions": {
+    "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta": {"type": "object", "properties": {"name": {"type": "string"}}},
+    "io.k8s.apimachinery.pkg.apis.meta.v1.Time": {"type": "string", "format": "date-time"},
+    "io.k8s.apimachinery.pkg.util.intstr.IntOrString": {"type": "string", "format": "int-or-string"},
+    "io.k8s.api.example.v1.Widget": {
+      "description": "Widget is an example kind.",
+      "type": "object",
+      "properties": {
+        "apiVersion": {"type": "string"},
+        "kind": {"type": "string"},
+        "metadata": {"$ref": "#/definitions/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"},
+        "spec": {"description": "Spec is the desired state.", "$ref": "#/definitions/io.k8s.api.example.v1.WidgetSpec"}
+      }
+    },
+    "io.k8s.api.example.v1.WidgetSpec": {
+      "type": "object",
+      "required": ["color"],
+      "properties": {
+        "color": {"type": "string"},
+        "replicas": {"type": "integer", "format": "int32"},
+        "size": {"type": "integer", "format": "int64"},
+        "paused": {"type": "boolean"},
+        "port": {"$ref": "#/definitions/io.k8s.apimachinery.pkg.util.intstr.IntOrString"},
+        "startedAt": {"$ref": "#/definitions/io.k8s.apimachinery.pkg.apis.meta.v1.Time"},
+        "hostIPs": {"type": "array", "items": {"type": "string"}},
+        "labels": {"type": "object", "additionalProperties": {"type": "string"}},
+        "uid": {"type": "string"},
+        "data": {"type": "string", "format": "byte"},
+        "raw": {"$ref": "#/definitions/io.k8s.apimachinery.pkg.runtime.RawExtension"},
+        "parts": {"type": "array", "items": {"$ref": "#/definitions/io.k8s.api.example.v1.WidgetPart"}}
+      }
+    },
+    "io.k8s.api.example.v1.WidgetPart": {
+      "type": "object",
+      "properties": {"name": {"type": "string"}}
+    },
+    "io.k8s.api.other.v1.WidgetPart": {
+      "type": "object",
+      "properties": {"id": {"type": "string"}}
+    },
+    "io.k8s.api.other.v1.Gadget": {
+      "type": "object",
+      "properties": {"part": {"$ref": "#/definitions/io.k8s.api.other.v1.WidgetPart"}}
+    }
+  }
+}`
+
+var spaces = regexp.MustCompile(`[ \t]+`)
+
+// testGenerate returns the generated source with runs of spaces and tabs
+// replaced by a single space, so that it does not depend on gofmt alignment.
+func testGenerate(t *testing.T, definitions ...string) (string, error) {
+	schemas, err := parseDocument([]byte(testSpec))
+	require.Nil(t, err)
+	declared := map[string]bool{"ObjectMeta": true, "Time": true, "IntOrString": true}
+	src, err := generate(schemas, declared, "client", "test.json", definitions)
+	return spaces.ReplaceAllString(string(src), " "), err
+}
+
+func TestGenerate(t *testing.T) {
+	src, err := testGenerate(t, "io.k8s.api.example.v1.Widget")
+	require.Nil(t, err)
+
+	for _, want := range []string{
+		"// Code generated by gen/openapi from test.json. DO NOT EDIT.",
+		`import "encoding/json"`,
+		"// Widget is an example kind.\ntype Widget struct {\n TypeMeta `json:\",inline\"`\n ObjectMeta `json:\"metadata,omitempty\"`",
+		"\n // Spec is the desired state.\n Spec *WidgetSpec `json:\"spec,omitempty\"`",
+		"\n raw unknownFields",
+		"func (w *Widget) UnmarshalJSON(data []byte) error {",
+		"func (w Widget) MarshalJSON() ([]byte, error) {",
+		"Color string `json:\"color\"`",
+		"\n Replicas *int32 `json:\"replicas,omitempty\"`",
+		"\n Size *int64 `json:\"size,omitempty\"`",
+		"\n Paused *bool `json:\"paused,omitempty\"`",
+		"\n Port *IntOrString `json:\"port,omitempty\"`",
+		"\n StartedAt *Time `json:\"startedAt,omitempty\"`",
+		"\n HostIPs []string `json:\"hostIPs,omitempty\"`",
+		"\n Labels map[string]string `json:\"labels,omitempty\"`",
+		"\n UID string `json:\"uid,omitempty\"`",
+		"\n Data []byte `json:\"data,omitempty\"`",
+		"\n Raw json.RawMessage `json:\"raw,omitempty\"`",
+		"\n Parts []WidgetPart `json:\"parts,omitempty\"`",
+		"type WidgetPart struct {",
+	} {
+		assert.Contains(t, src, want)
+	}
+	assert.NotContains(t, src, "type ObjectMeta", "declared types should not be generated")
+	assert.NotContains(t, src, "WidgetSpec) MarshalJSON", "only kinds keep unknown fields")
+}
+
+func TestGenerateConflicts(t *testing.T) {
+	_, err := testGenerate(t, "io.k8s.api.example.v1.Widget", "io.k8s.api.other.v1.Gadget")
+	require.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "WidgetPart"), err.Error())
+
+	_, err = testGenerate(t, "io.k8s.api.example.v1.Missing")
+	assert.NotNil(t, err)
+}
+
+func TestOpenAPIV3(t *testing.T) {
+	spec := `{
+  "openapi": "3.0.0",
+  "components": {"schemas": {
+    "io.k8s.api.example.v1.Box": {
+      "type": "object",
+      "properties": {
+        "lid": {"description": "Lid of the box.", "allOf": [{"$ref": "#/components/schemas/io.k8s.api.example.v1.Lid"}]}
+      }
+    },
+    "io.k8s.api.example.v1.Lid": {"type": "object", "properties": {"open": {"type": "boolean"}}}
+  }}
+}`
+	schemas, err := parseDocument([]byte(spec))
+	require.Nil(t, err)
+	src, err := generate(schemas, nil, "client", "v3.json", []string{"io.k8s.api.example.v1.Box"})
+	require.Nil(t, err)
+	assert.Contains(t, string(src), "\t// Lid of the box.\n\tLid *Lid `json:\"lid,omitempty\"`\n")
+	assert.Contains(t, string(src), "type Lid struct {")
+}
+
+// TestGeneratedTypes checks that the types in the root package are up to date
+// with the pinned OpenAPI document.
+func TestGeneratedTypes(t *testing.T) {
+	data, err := ioutil.ReadFile("../swagger.json")
+	require.Nil(t, err)
+	schemas, err := parseDocument(data)
+	require.Nil(t, err)
+	declared, err := declaredTypes("../..", "zz_generated_types.go")
+	require.Nil(t, err)
+	want, err := generate(schemas, declared, "client", "swagger.json", []string{
+		"io.k8s.api.scheduling.v1.PriorityClass",
+		"io.k8s.api.scheduling.v1.PriorityClassList",
+	})
+	require.Nil(t, err)
+
+	got, err := ioutil.ReadFile("../../zz_generated_types.go")
+	require.Nil(t, err)
+	assert.Equal(t, string(want), string(got), "run go generate")
+}
+
+func TestFieldName(t *testing.T) {
+	for in, want := range map[string]string{
+		"podIP":                          "PodIP",
+		"uid":                            "UID",
+		"x-kubernetes-embedded-resource": "XKubernetesEmbeddedResource",
+		"$ref":                           "Ref",
+		"2fa":                            "Field2fa",
+	} {
+		assert.Equal(t, want, fieldName(in), in)
+	}
+}
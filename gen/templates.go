@@ -0,0 +1,370 @@
+package main
+
+import (
+	"text/template"
+)
+
+const header = `// Code generated by gen from gen/kinds.json. DO NOT EDIT.
+`
+
+var rootTemplate = template.Must(template.New("root").Parse(header + `
+package client
+
+type (
+	// TypedClient has the typed methods for every kind in gen/kinds.json.
+	TypedClient interface {
+{{- range .}}
+		{{.Kind}}Interface
+{{- end}}
+	}
+{{range .}}
+	// {{.Kind}}Interface has methods to work with {{.Kind}} resources.
+	{{.Kind}}Interface interface {
+		Create{{.Kind}}({{.NsParam}}item *{{.Kind}}) (*{{.Kind}}, error)
+		Get{{.Kind}}({{.NsNameParams}}) (result *{{.Kind}}, err error)
+		List{{.PluralKind}}({{.NsParam}}opts *ListOptions) (*{{.Kind}}List, error)
+		Watch{{.PluralKind}}({{.NsParam}}opts *WatchOptions, events chan {{.Kind}}WatchEvent) error
+		Delete{{.Kind}}({{.NsNameParams}}) error
+		Update{{.Kind}}({{.NsParam}}item *{{.Kind}}) (*{{.Kind}}, error)
+{{- if .HasStatus}}
+		Update{{.Kind}}Status({{.NsParam}}item *{{.Kind}}) (*{{.Kind}}, error)
+{{- end}}
+{{- if .Expansion}}
+		{{.Kind}}Expansion
+{{- end}}
+	}
+
+	{{.Kind}}WatchEvent interface {
+		Type() WatchEventType
+		Object() (*{{.Kind}}, error)
+	}
+{{end}}
+)
+
+// kindGroupVersions holds the group versions of each kind, in order of
+// preference.
+var kindGroupVersions = map[string][]string{
+{{- range .}}
+	"{{.Kind}}": {{.GroupVersionsLiteral}},
+{{- end}}
+}
+{{range .}}
+// {{.Kind}}ListWatcher returns a ListWatcher for the named {{.Kind}}.
+func {{.Kind}}ListWatcher(c {{.Kind}}Interface, {{.NsNameParams}}) ListWatcher {
+	return &listWatch{
+		name: name,
+		list: func(opts *ListOptions) ([]Object, string, error) {
+			list, err := c.List{{.PluralKind}}({{.NsArg}}opts)
+			if err != nil {
+				return nil, "", err
+			}
+			items := make([]Object, len(list.Items))
+			for i := range list.Items {
+				items[i] = &list.Items[i]
+			}
+			return items, list.ResourceVersion, nil
+		},
+		watch: func(opts *WatchOptions, events chan<- ObjectEvent) error {
+			typed := make(chan {{.Kind}}WatchEvent)
+			go func() {
+				defer close(events)
+				for ev := range typed {
+					obj, err := ev.Object()
+					if err != nil {
+						events <- ObjectEvent{Type: WatchEventTypeError, Err: err}
+						continue
+					}
+					events <- ObjectEvent{Type: ev.Type(), Object: obj}
+				}
+			}()
+			return c.Watch{{.PluralKind}}({{.NsArg}}opts, typed)
+		},
+	}
+}
+{{end}}
+`))
+
+var httpTemplate = template.Must(template.New("http").Parse(header + `
+package http
+
+import (
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+// {{.Lower}}Info describes {{.Kind}}. The group versions are in order of preference.
+var {{.Lower}}Info = ResourceInfo{
+	Kind:          "{{.Kind}}",
+	Resource:      "{{.Plural}}",
+	GroupVersions: {{.GroupVersionsLiteral}},
+	Namespaced:    {{.Namespaced}},
+}
+
+func init() {
+	register[k8s.{{.Kind}}]({{.Lower}}Info)
+}
+
+// {{.PluralKind}} returns a typed client for {{.PluralKind}}.
+func (c *Client) {{.PluralKind}}() *ResourceClient[k8s.{{.Kind}}, k8s.{{.Kind}}List] {
+	return newResourceClient[k8s.{{.Kind}}, k8s.{{.Kind}}List](c, {{.Lower}}Info)
+}
+
+// Get{{.Kind}} fetches a single {{.Kind}}
+func (c *Client) Get{{.Kind}}({{.NsNameParams}}) (*k8s.{{.Kind}}, error) {
+	return c.{{.PluralKind}}().Get({{.NsValue}}, name)
+}
+
+// Create{{.Kind}} creates a new {{.Kind}}. This will fail if it already exists.
+func (c *Client) Create{{.Kind}}({{.NsParam}}item *k8s.{{.Kind}}) (*k8s.{{.Kind}}, error) {
+	return c.{{.PluralKind}}().Create({{.NsValue}}, item)
+}
+
+// List{{.PluralKind}} lists all {{.PluralKind}}{{if .Namespaced}} in a namespace{{end}}
+func (c *Client) List{{.PluralKind}}({{.NsParam}}opts *k8s.ListOptions) (*k8s.{{.Kind}}List, error) {
+	return c.{{.PluralKind}}().List({{.NsValue}}, opts)
+}
+
+// Watch{{.PluralKind}} watches all {{.Kind}} changes{{if .Namespaced}} in a namespace{{end}}
+func (c *Client) Watch{{.PluralKind}}({{.NsParam}}opts *k8s.WatchOptions, events chan k8s.{{.Kind}}WatchEvent) error {
+	if events == nil {
+		return errors.New("events must not be nil")
+	}
+	defer close(events)
+	return c.{{.PluralKind}}().watch({{.NsValue}}, opts, func(ev *watchEvent[k8s.{{.Kind}}]) {
+		events <- ev
+	})
+}
+
+// Delete{{.Kind}} deletes a single {{.Kind}}. It will error if the {{.Kind}} does not exist.
+func (c *Client) Delete{{.Kind}}({{.NsNameParams}}) error {
+	return c.{{.PluralKind}}().Delete({{.NsValue}}, name)
+}
+
+// Update{{.Kind}} will update in place a single {{.Kind}}. Generally, you should call
+// Get and then use that object for updates to ensure resource versions
+// avoid update conflicts
+func (c *Client) Update{{.Kind}}({{.NsParam}}item *k8s.{{.Kind}}) (*k8s.{{.Kind}}, error) {
+	return c.{{.PluralKind}}().Update({{.NsValue}}, item)
+}
+{{if .HasStatus}}
+// Update{{.Kind}}Status updates the status of a single {{.Kind}} using the status
+// subresource. The server ignores changes to anything but the status.
+func (c *Client) Update{{.Kind}}Status({{.NsParam}}item *k8s.{{.Kind}}) (*k8s.{{.Kind}}, error) {
+	return c.{{.PluralKind}}().UpdateStatus({{.NsValue}}, item)
+}
+{{end}}
+`))
+
+var fakeTemplate = template.Must(template.New("fake").Parse(header + `
+package fake
+
+import (
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+// kinds are the kinds with typed methods, at each group version they are served at.
+var kinds = []kind{
+{{- range .}}
+	{{- $k := .}}
+	{{- range .GroupVersions}}
+	{gvk: k8s.GroupVersionKind{Group: "{{$k.GroupOf .}}", Version: "{{$k.VersionOf .}}", Kind: "{{$k.Kind}}"}, resource: "{{$k.Plural}}", namespaced: {{$k.Namespaced}}{{if $k.Subresources}}, subresources: {{$k.SubresourcesLiteral}}{{end}}},
+	{{- end}}
+{{- end}}
+}
+
+var (
+{{- range .}}
+	{{.Lower}}Resource = k8s.GroupVersionResource{Group: "{{.Group}}", Version: "{{.Version}}", Resource: "{{.Plural}}"}
+{{- end}}
+)
+
+// addTyped adds obj to the tracker if it is one of the typed kinds. It
+// returns false for other objects.
+func (c *Client) addTyped(obj k8s.Object) (bool, error) {
+	switch o := obj.(type) {
+{{- range .}}
+	case *k8s.{{.Kind}}:
+		if o.TypeMeta.APIVersion == "" {
+			o.TypeMeta.APIVersion = {{.Lower}}Resource.GroupVersion()
+		}
+		o.TypeMeta.Kind = "{{.Kind}}"
+		return true, c.tracker.create({{.Lower}}Resource, {{if .Namespaced}}o.Namespace{{else}}""{{end}}, o, nil)
+{{- end}}
+	}
+	return false, nil
+}
+{{range .}}
+type watchEvent{{.Kind}} struct {
+	raw    k8s.WatchEvent
+	object *k8s.{{.Kind}}
+}
+
+func (w *watchEvent{{.Kind}}) Type() k8s.WatchEventType {
+	return w.raw.Type
+}
+
+func (w *watchEvent{{.Kind}}) Object() (*k8s.{{.Kind}}, error) {
+	if w.object != nil {
+		return w.object, nil
+	}
+	if w.raw.Type == k8s.WatchEventTypeError {
+		var status k8s.Status
+		if err := w.raw.UnmarshalObject(&status); err != nil {
+			return nil, errors.Wrap(err, "failed to decode Status")
+		}
+		return nil, &status
+	}
+	var object k8s.{{.Kind}}
+	if err := w.raw.UnmarshalObject(&object); err != nil {
+		return nil, errors.Wrap(err, "failed to decode {{.Kind}}")
+	}
+	w.object = &object
+	return &object, nil
+}
+
+// Get{{.Kind}} fetches a single {{.Kind}}
+func (c *Client) Get{{.Kind}}({{.NsNameParams}}) (*k8s.{{.Kind}}, error) {
+	var out k8s.{{.Kind}}
+	if err := c.tracker.get({{.Lower}}Resource, {{.NsValue}}, name, &out); err != nil {
+		return nil, errors.Wrap(err, "failed to get {{.Kind}}")
+	}
+	return &out, nil
+}
+
+// Create{{.Kind}} creates a new {{.Kind}}. This will fail if it already exists.
+func (c *Client) Create{{.Kind}}({{.NsParam}}item *k8s.{{.Kind}}) (*k8s.{{.Kind}}, error) {
+	item.TypeMeta.Kind = "{{.Kind}}"
+	item.TypeMeta.APIVersion = {{.Lower}}Resource.GroupVersion()
+{{- if .Namespaced}}
+	item.ObjectMeta.Namespace = namespace
+{{- end}}
+
+	var out k8s.{{.Kind}}
+	if err := c.tracker.create({{.Lower}}Resource, {{.NsValue}}, item, &out); err != nil {
+		return nil, errors.Wrap(err, "failed to create {{.Kind}}")
+	}
+	return &out, nil
+}
+
+// List{{.PluralKind}} lists all {{.PluralKind}}{{if .Namespaced}} in a namespace{{end}}
+func (c *Client) List{{.PluralKind}}({{.NsParam}}opts *k8s.ListOptions) (*k8s.{{.Kind}}List, error) {
+	var out k8s.{{.Kind}}List
+	if err := c.tracker.list({{.Lower}}Resource, {{.NsValue}}, opts, &out); err != nil {
+		return nil, errors.Wrap(err, "failed to list {{.PluralKind}}")
+	}
+	return &out, nil
+}
+
+// Watch{{.PluralKind}} watches all {{.Kind}} changes{{if .Namespaced}} in a namespace{{end}}
+func (c *Client) Watch{{.PluralKind}}({{.NsParam}}opts *k8s.WatchOptions, events chan k8s.{{.Kind}}WatchEvent) error {
+	if events == nil {
+		return errors.New("events must not be nil")
+	}
+	rawEvents := make(chan k8s.WatchEvent)
+	go func() {
+		for rawEvent := range rawEvents {
+			events <- &watchEvent{{.Kind}}{raw: rawEvent}
+		}
+		close(events)
+	}()
+	err := c.tracker.watch({{.Lower}}Resource, {{.NsValue}}, opts, rawEvents)
+	return errors.Wrap(err, "failed to watch {{.PluralKind}}")
+}
+
+// Delete{{.Kind}} deletes a single {{.Kind}}. It will error if the {{.Kind}} does not exist.
+func (c *Client) Delete{{.Kind}}({{.NsNameParams}}) error {
+	err := c.tracker.delete({{.Lower}}Resource, {{.NsValue}}, name)
+	return errors.Wrap(err, "failed to delete {{.Kind}}")
+}
+
+// Update{{.Kind}} will update in place a single {{.Kind}}.
+func (c *Client) Update{{.Kind}}({{.NsParam}}item *k8s.{{.Kind}}) (*k8s.{{.Kind}}, error) {
+	item.TypeMeta.Kind = "{{.Kind}}"
+	item.TypeMeta.APIVersion = {{.Lower}}Resource.GroupVersion()
+{{- if .Namespaced}}
+	item.ObjectMeta.Namespace = namespace
+{{- end}}
+
+	var out k8s.{{.Kind}}
+	if err := c.tracker.update({{.Lower}}Resource, {{.NsValue}}, item, &out, ""); err != nil {
+		return nil, errors.Wrap(err, "failed to update {{.Kind}}")
+	}
+	return &out, nil
+}
+{{if .HasStatus}}
+// Update{{.Kind}}Status updates the status of a single {{.Kind}}. Changes to
+// anything but the status are ignored.
+func (c *Client) Update{{.Kind}}Status({{.NsParam}}item *k8s.{{.Kind}}) (*k8s.{{.Kind}}, error) {
+	item.TypeMeta.Kind = "{{.Kind}}"
+	item.TypeMeta.APIVersion = {{.Lower}}Resource.GroupVersion()
+{{- if .Namespaced}}
+	item.ObjectMeta.Namespace = namespace
+{{- end}}
+
+	var out k8s.{{.Kind}}
+	if err := c.tracker.update({{.Lower}}Resource, {{.NsValue}}, item, &out, "status"); err != nil {
+		return nil, errors.Wrap(err, "failed to update {{.Kind}} status")
+	}
+	return &out, nil
+}
+{{end}}
+{{- end}}
+`))
+
+var fakeTestTemplate = template.Must(template.New("fakeTest").Parse(header + `
+package fake
+
+import (
+	"testing"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+{{range .}}
+func Test{{.Kind}}(t *testing.T) {
+	c, err := New()
+	require.Nil(t, err)
+{{if .Namespaced}}
+	namespace := "default"
+{{- end}}
+	name := "test"
+
+	item := &k8s.{{.Kind}}{ObjectMeta: k8s.NewObjectMeta({{.NsValue}}, name)}
+	created, err := c.Create{{.Kind}}({{.NsArg}}item)
+	require.Nil(t, err)
+	assert.Equal(t, name, created.Name)
+	assert.NotEmpty(t, created.UID)
+	assert.NotEmpty(t, created.ResourceVersion)
+
+	_, err = c.Create{{.Kind}}({{.NsArg}}item)
+	assert.True(t, k8s.IsConflictError(err), "creating twice should conflict")
+
+	got, err := c.Get{{.Kind}}({{.NsArg}}name)
+	require.Nil(t, err)
+	assert.Equal(t, created.UID, got.UID)
+
+	list, err := c.List{{.PluralKind}}({{.NsArg}}nil)
+	require.Nil(t, err)
+	assert.Len(t, list.Items, 1)
+
+	got.Labels = map[string]string{"updated": "true"}
+	updated, err := c.Update{{.Kind}}({{.NsArg}}got)
+	require.Nil(t, err)
+	assert.Equal(t, "true", updated.Labels["updated"])
+	assert.NotEqual(t, created.ResourceVersion, updated.ResourceVersion)
+
+	list, err = c.List{{.PluralKind}}({{.NsArg}}&k8s.ListOptions{LabelSelector: k8s.LabelSelector{MatchLabels: map[string]string{"updated": "false"}}})
+	require.Nil(t, err)
+	assert.Len(t, list.Items, 0)
+
+	_, err = c.Update{{.Kind}}({{.NsArg}}got)
+	assert.True(t, k8s.IsConflictError(err), "updating a stale copy should conflict")
+
+	require.Nil(t, c.Delete{{.Kind}}({{.NsArg}}name))
+	_, err = c.Get{{.Kind}}({{.NsArg}}name)
+	assert.True(t, k8s.IsNotFoundError(err))
+}
+{{end}}
+`))
@@ -1,20 +1,6 @@
 package client
 
 type (
-	HorizontalPodAutoscalerInterface interface {
-		CreateHorizontalPodAutoscaler(namespace string, item *HorizontalPodAutoscaler) (*HorizontalPodAutoscaler, error)
-		GetHorizontalPodAutoscaler(namespace, name string) (result *HorizontalPodAutoscaler, err error)
-		ListHorizontalPodAutoscalers(namespace string, opts *ListOptions) (*HorizontalPodAutoscalerList, error)
-		WatchHorizontalPodAutoscalers(namespace string, opts *WatchOptions, events chan HorizontalPodAutoscalerWatchEvent) error
-		DeleteHorizontalPodAutoscaler(namespace, name string) error
-		UpdateHorizontalPodAutoscaler(namespace string, item *HorizontalPodAutoscaler) (*HorizontalPodAutoscaler, error)
-	}
-
-	HorizontalPodAutoscalerWatchEvent interface {
-		Type() WatchEventType
-		Object() (*HorizontalPodAutoscaler, error)
-	}
-
 	// list of horizontal pod autoscaler objects.
 	HorizontalPodAutoscalerList struct {
 		TypeMeta `json:",inline"`
@@ -34,6 +20,9 @@ type (
 
 		// current information about the autoscaler.
 		Status *HorizontalPodAutoscalerStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// current status of a horizontal pod autoscaler
@@ -89,3 +78,17 @@ func NewHorizontalPodAutoscaler(namespace, name string) *HorizontalPodAutoscaler
 		Spec:       &HorizontalPodAutoscalerSpec{},
 	}
 }
+
+// UnmarshalJSON decodes the HorizontalPodAutoscaler, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (h *HorizontalPodAutoscaler) UnmarshalJSON(data []byte) error {
+	type alias HorizontalPodAutoscaler
+	return unmarshalKeepingUnknown(data, (*alias)(h), &h.raw)
+}
+
+// MarshalJSON encodes the HorizontalPodAutoscaler, including any fields it was decoded with
+// that this package does not model.
+func (h HorizontalPodAutoscaler) MarshalJSON() ([]byte, error) {
+	type alias HorizontalPodAutoscaler
+	return marshalKeepingUnknown(alias(h), h.raw)
+}
@@ -12,25 +12,13 @@ import (
 	http "net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	k8s "github.com/bakins/k8s-client"
 	"github.com/pkg/errors"
 )
 
-//go:generate ./make-type HorizontalPodAutoscaler autoscaling/v1
-//go:generate ./make-type Secret v1
-//go:generate ./make-type DaemonSet extensions/v1beta1
-//go:generate ./make-type Deployment extensions/v1beta1
-//go:generate ./make-type Ingress extensions/v1beta1 es
-//go:generate ./make-type Job batch/v1
-//go:generate ./make-type Pod v1
-//go:generate ./make-type ConfigMap v1
-//go:generate ./make-type ReplicaSet extensions/v1beta1
-//go:generate ./make-type Service v1
-//go:generate ./make-type ServiceAccount v1
-//go:generate ./make-type Endpoints v1 -
-
 const (
 	tokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 	caFile    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
@@ -49,6 +37,7 @@ type (
 		clientKey          []byte
 		insecureSkipVerify bool
 		client             *http.Client
+		discovery          discoveryCache
 	}
 
 	// OptionsFunc is a function passed to new for setting options on a new client.
@@ -57,7 +46,11 @@ type (
 
 // New creates a new client.
 func New(options ...OptionsFunc) (*Client, error) {
-	c := &Client{}
+	c := &Client{
+		discovery: discoveryCache{
+			ttl: defaultDiscoveryTTL,
+		},
+	}
 	for _, f := range options {
 		if err := f(c); err != nil {
 			return nil, err
@@ -239,21 +232,28 @@ func (c *Client) newRequest(method, path string, v interface{}) (*http.Request,
 		if err != nil {
 			return nil, err
 		}
-		req, err := http.NewRequest(method, c.server+path, bytes.NewBuffer(data))
-		if err != nil {
-			return nil, err
-		}
-		if c.authHeader != "" {
-			req.Header.Add("Authorization", c.authHeader)
-		}
-		return req, nil
+		return c.newRawRequest(method, path, "application/json", data)
+	}
+	return c.newRawRequest(method, path, "", nil)
+}
+
+// newRawRequest creates a request with the given body, which is sent with
+// the content type if it is not nil.
+func (c *Client) newRawRequest(method, path, contentType string, body []byte) (*http.Request, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
 	}
-	// weirdness with passing nil interface, so just copy/paste
-	req, err := http.NewRequest(method, c.server+path, nil)
+	req, err := http.NewRequest(method, c.server+path, r)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Add("Authorization", c.authHeader)
+	if body != nil && contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.authHeader != "" {
+		req.Header.Add("Authorization", c.authHeader)
+	}
 	return req, nil
 }
 
@@ -269,12 +269,46 @@ func readStatus(body io.Reader) (*k8s.Status, error) {
 	return &out, nil
 }
 
+// checkStatus returns the Status sent by the server as an error if the
+// response code is not one of codes. codes defaults to 200.
+func checkStatus(resp *http.Response, codes []int) error {
+	if len(codes) == 0 {
+		codes = []int{
+			200,
+		}
+	}
+
+	for _, i := range codes {
+		if i == resp.StatusCode {
+			return nil
+		}
+	}
+
+	status, err := readStatus(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read status: %d", resp.StatusCode)
+	}
+	return status
+}
+
 func (c *Client) do(method, path string, in interface{}, out interface{}, codes ...int) (int, error) {
 	req, err := c.newRequest(method, path, in)
 	if err != nil {
 		return 0, err
 	}
+	return c.doRequest(req, out, codes...)
+}
+
+// doPatch sends data as a patch of the given type.
+func (c *Client) doPatch(path string, pt k8s.PatchType, data []byte, out interface{}, codes ...int) (int, error) {
+	req, err := c.newRawRequest("PATCH", path, string(pt), data)
+	if err != nil {
+		return 0, err
+	}
+	return c.doRequest(req, out, codes...)
+}
 
+func (c *Client) doRequest(req *http.Request, out interface{}, codes ...int) (int, error) {
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return 0, err
@@ -285,26 +319,8 @@ func (c *Client) do(method, path string, in interface{}, out interface{}, codes
 		_ = resp.Body.Close()
 	}()
 
-	if len(codes) == 0 {
-		codes = []int{
-			200,
-		}
-	}
-
-	found := false
-	for _, i := range codes {
-		if i == resp.StatusCode {
-			found = true
-			break
-		}
-	}
-
-	if !found {
-		status, err := readStatus(resp.Body)
-		if err != nil {
-			return resp.StatusCode, errors.Wrapf(err, "unable to read status: %d", resp.StatusCode)
-		}
-		return resp.StatusCode, status
+	if err := checkStatus(resp, codes); err != nil {
+		return resp.StatusCode, err
 	}
 
 	if out != nil {
@@ -319,7 +335,13 @@ func (c *Client) do(method, path string, in interface{}, out interface{}, codes
 	return resp.StatusCode, nil
 }
 
+// doWatch streams watch events into out until the server ends the watch.
+// out is closed when doWatch returns.
 func (c *Client) doWatch(method, path string, in interface{}, out chan k8s.WatchEvent, codes ...int) (int, error) {
+	if out != nil {
+		defer close(out)
+	}
+
 	req, err := c.newRequest(method, path, in)
 	if err != nil {
 		return 0, err
@@ -335,26 +357,8 @@ func (c *Client) doWatch(method, path string, in interface{}, out chan k8s.Watch
 		_ = resp.Body.Close()
 	}()
 
-	if len(codes) == 0 {
-		codes = []int{
-			200,
-		}
-	}
-
-	found := false
-	for _, i := range codes {
-		if i == resp.StatusCode {
-			found = true
-			break
-		}
-	}
-
-	if !found {
-		status, err := readStatus(resp.Body)
-		if err != nil {
-			return resp.StatusCode, errors.Wrapf(err, "unable to read status: %d", resp.StatusCode)
-		}
-		return resp.StatusCode, status
+	if err := checkStatus(resp, codes); err != nil {
+		return resp.StatusCode, err
 	}
 
 	if out != nil {
@@ -362,6 +366,10 @@ func (c *Client) doWatch(method, path string, in interface{}, out chan k8s.Watch
 		for {
 			var ev k8s.WatchEvent
 			if err := decoder.Decode(&ev); err != nil {
+				if err == io.EOF {
+					// the server ended the watch
+					return resp.StatusCode, nil
+				}
 				return resp.StatusCode, err
 			}
 			out <- ev
@@ -375,12 +383,8 @@ func listOptionsQuery(opts *k8s.ListOptions, val url.Values) string {
 		if val == nil {
 			val = url.Values{}
 		}
-		if opts.LabelSelector.MatchLabels != nil && len(opts.LabelSelector.MatchLabels) > 0 {
-			var labels []string
-			for k, v := range opts.LabelSelector.MatchLabels {
-				labels = append(labels, fmt.Sprintf("%s=%s", k, v))
-			}
-			val.Set("labelSelector", strings.Join(labels, ","))
+		if selector := opts.LabelSelector.String(); selector != "" {
+			val.Set("labelSelector", selector)
 		}
 		if opts.FieldSelector != nil && len(opts.FieldSelector) > 0 {
 			var fields []string
@@ -403,6 +407,9 @@ func watchOptionsQuery(opts *k8s.WatchOptions) string {
 		if opts.ResourceVersion != "" {
 			val.Set("resourceVersion", opts.ResourceVersion)
 		}
+		if opts.TimeoutSeconds > 0 {
+			val.Set("timeoutSeconds", strconv.FormatInt(opts.TimeoutSeconds, 10))
+		}
 		return listOptionsQuery(&opts.ListOptions, val)
 	}
 	return val.Encode()
@@ -9,7 +9,7 @@ import (
 )
 
 // create a test client based on env variables.
-func testClient(t *testing.T) *http.Client {
+func testClient(t *testing.T, options ...http.OptionsFunc) *http.Client {
 	server := os.Getenv("K8S_SERVER")
 
 	if server == "" {
@@ -32,7 +32,7 @@ func testClient(t *testing.T) *http.Client {
 		opts = append(opts, http.SetClientKeyFromFile(clientKey))
 	}
 
-	c, err := http.New(opts...)
+	c, err := http.New(append(opts, options...)...)
 	require.Nil(t, err)
 
 	return c
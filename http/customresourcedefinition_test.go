@@ -0,0 +1,91 @@
+package http_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	cronTab struct {
+		client.TypeMeta   `json:",inline"`
+		client.ObjectMeta `json:"metadata,omitempty"`
+		Spec              cronTabSpec `json:"spec"`
+	}
+
+	cronTabSpec struct {
+		CronSpec string `json:"cronSpec"`
+		Image    string `json:"image"`
+		Replicas int    `json:"replicas,omitempty"`
+	}
+
+	cronTabList struct {
+		client.TypeMeta `json:",inline"`
+		client.ListMeta `json:"metadata,omitempty"`
+		Items           []cronTab `json:"items"`
+	}
+)
+
+func TestCustomResourceDefinition(t *testing.T) {
+	c := testClient(t)
+
+	crd := client.NewCustomResourceDefinition("stable.example.com", "crontabs", "CronTab", client.NamespaceScoped)
+	preserve := true
+	crd.Spec.Versions = []client.CustomResourceDefinitionVersion{{
+		Name:    "v1",
+		Served:  true,
+		Storage: true,
+		Schema: &client.CustomResourceValidation{OpenAPIV3Schema: &client.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]client.JSONSchemaProps{
+				"spec": {Type: "object", XPreserveUnknownFields: &preserve},
+			},
+		}},
+	}}
+
+	_, err := c.CreateCustomResourceDefinition(crd)
+	require.Nil(t, err)
+	defer func() {
+		assert.Nil(t, c.DeleteCustomResourceDefinition(crd.Name))
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	established, err := client.WaitForCustomResourceDefinitionEstablished(ctx, c, crd.Name)
+	require.Nil(t, err)
+
+	require.Nil(t, http.RegisterCustomResource[cronTab](established))
+
+	withTestNamespace(t, func(t *testing.T, c *http.Client, n *client.Namespace) {
+		crontabs, err := http.ResourceFor[cronTab, cronTabList](c)
+		require.Nil(t, err)
+		groupVersion, err := crontabs.GroupVersion()
+		require.Nil(t, err)
+		assert.Equal(t, "stable.example.com/v1", groupVersion)
+
+		out, err := crontabs.Create(n.Name, &cronTab{
+			ObjectMeta: client.ObjectMeta{Name: "backup"},
+			Spec:       cronTabSpec{CronSpec: "* * * * */5", Image: "backup"},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "backup", out.Spec.Image)
+
+		out, err = crontabs.Patch(n.Name, "backup", client.MergePatchType, []byte(`{"spec":{"replicas":2}}`))
+		require.Nil(t, err)
+		assert.Equal(t, 2, out.Spec.Replicas)
+
+		list, err := crontabs.List(n.Name, nil)
+		require.Nil(t, err)
+		require.Len(t, list.Items, 1)
+		assert.Equal(t, "* * * * */5", list.Items[0].Spec.CronSpec)
+
+		require.Nil(t, crontabs.Delete(n.Name, "backup"))
+		_, err = crontabs.Get(n.Name, "backup")
+		assert.True(t, client.IsNotFoundError(err))
+	})
+}
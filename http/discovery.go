@@ -0,0 +1,230 @@
+package http
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+// defaultDiscoveryTTL is how long discovery results are cached by default.
+const defaultDiscoveryTTL = 10 * time.Minute
+
+type (
+	// discoveryCache holds discovery results until they expire. The lock is
+	// not held while results are fetched, and results fetched before the
+	// cache was invalidated are not stored.
+	discoveryCache struct {
+		sync.Mutex
+		ttl        time.Duration
+		generation int
+		groups     *k8s.APIGroupList
+		fetched    time.Time
+		resources  map[string]cachedResources
+		// groupVersions holds the group version chosen for each list of
+		// group versions. It does not expire.
+		groupVersions map[string]string
+	}
+
+	cachedResources struct {
+		list    *k8s.APIResourceList
+		fetched time.Time
+	}
+)
+
+// SetDiscoveryTTL sets how long the results of discovery are cached. Zero
+// disables caching. The group versions chosen for the typed clients are
+// kept until InvalidateDiscovery is called regardless.
+func SetDiscoveryTTL(ttl time.Duration) func(*Client) error {
+	return func(c *Client) error {
+		if ttl < 0 {
+			return errors.New("discovery ttl must not be negative")
+		}
+		c.discovery.ttl = ttl
+		return nil
+	}
+}
+
+func (d *discoveryCache) fresh(fetched time.Time) bool {
+	return d.ttl > 0 && time.Since(fetched) < d.ttl
+}
+
+// InvalidateDiscovery drops any cached discovery results, such as after a
+// CustomResourceDefinition is created.
+func (c *Client) InvalidateDiscovery() {
+	c.discovery.Lock()
+	defer c.discovery.Unlock()
+	c.discovery.generation++
+	c.discovery.groups = nil
+	c.discovery.resources = nil
+	c.discovery.groupVersions = nil
+}
+
+// ServerGroups returns the groups supported by the server. The core group,
+// served at /api, is first and has an empty name. The result is a copy and
+// may be modified.
+func (c *Client) ServerGroups() (*k8s.APIGroupList, error) {
+	c.discovery.Lock()
+	if c.discovery.groups != nil && c.discovery.fresh(c.discovery.fetched) {
+		defer c.discovery.Unlock()
+		return c.discovery.groups.DeepCopy(), nil
+	}
+	generation := c.discovery.generation
+	c.discovery.Unlock()
+
+	var versions k8s.APIVersions
+	if _, err := c.do("GET", "/api", nil, &versions); err != nil {
+		return nil, errors.Wrap(err, "failed to get core API versions")
+	}
+	var groups k8s.APIGroupList
+	if _, err := c.do("GET", "/apis", nil, &groups); err != nil {
+		return nil, errors.Wrap(err, "failed to get API groups")
+	}
+
+	core := k8s.APIGroup{}
+	for _, v := range versions.Versions {
+		core.Versions = append(core.Versions, k8s.GroupVersionForDiscovery{GroupVersion: v, Version: v})
+	}
+	if len(core.Versions) > 0 {
+		core.PreferredVersion = core.Versions[0]
+	}
+	groups.Groups = append([]k8s.APIGroup{core}, groups.Groups...)
+
+	c.discovery.Lock()
+	defer c.discovery.Unlock()
+	if c.discovery.generation == generation {
+		c.discovery.groups = groups.DeepCopy()
+		c.discovery.fetched = time.Now()
+	}
+	return &groups, nil
+}
+
+// ServerResourcesForGroupVersion returns the resources supported by the
+// server for a group version, such as "v1" or "apps/v1". Subresources are
+// included with names such as "pods/log". The result is a copy and may be
+// modified.
+func (c *Client) ServerResourcesForGroupVersion(groupVersion string) (*k8s.APIResourceList, error) {
+	c.discovery.Lock()
+	if cached, ok := c.discovery.resources[groupVersion]; ok && c.discovery.fresh(cached.fetched) {
+		defer c.discovery.Unlock()
+		return cached.list.DeepCopy(), nil
+	}
+	generation := c.discovery.generation
+	c.discovery.Unlock()
+
+	path := "/apis/" + groupVersion
+	if group, _ := k8s.ParseGroupVersion(groupVersion); group == "" {
+		path = "/api/" + groupVersion
+	}
+	var list k8s.APIResourceList
+	if _, err := c.do("GET", path, nil, &list); err != nil {
+		return nil, errors.Wrapf(err, "failed to get resources for %s", groupVersion)
+	}
+
+	c.discovery.Lock()
+	defer c.discovery.Unlock()
+	if c.discovery.generation == generation {
+		if c.discovery.resources == nil {
+			c.discovery.resources = make(map[string]cachedResources)
+		}
+		c.discovery.resources[groupVersion] = cachedResources{list: list.DeepCopy(), fetched: time.Now()}
+	}
+	return &list, nil
+}
+
+// ServerPreferredResources returns the resources of the preferred version of
+// each group. If some group versions fail, such as an aggregated API whose
+// server is down, the resources of the others are returned with a
+// *k8s.GroupDiscoveryFailedError.
+func (c *Client) ServerPreferredResources() ([]*k8s.APIResourceList, error) {
+	groups, err := c.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+	var out []*k8s.APIResourceList
+	failed := make(map[string]error)
+	for _, g := range groups.Groups {
+		gv := g.PreferredVersion.GroupVersion
+		list, err := c.ServerResourcesForGroupVersion(gv)
+		if err != nil {
+			failed[gv] = err
+			continue
+		}
+		out = append(out, list)
+	}
+	if len(failed) > 0 {
+		return out, &k8s.GroupDiscoveryFailedError{Groups: failed}
+	}
+	return out, nil
+}
+
+// RESTMapping returns the resource for a kind. The versions are tried in
+// order. If none are given, the preferred version of the group is tried
+// first and then its other versions.
+func (c *Client) RESTMapping(kind k8s.GroupKind, versions ...string) (*k8s.RESTMapping, error) {
+	groups, err := c.ServerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var group *k8s.APIGroup
+	for i := range groups.Groups {
+		if groups.Groups[i].Name == kind.Group {
+			group = &groups.Groups[i]
+			break
+		}
+	}
+	if group == nil {
+		return nil, errors.Errorf("no API group %q for kind %s", kind.Group, kind.Kind)
+	}
+
+	if len(versions) == 0 {
+		versions = append(versions, group.PreferredVersion.Version)
+		for _, v := range group.Versions {
+			if v.Version != group.PreferredVersion.Version {
+				versions = append(versions, v.Version)
+			}
+		}
+	}
+
+	for _, version := range versions {
+		gv := k8s.GroupVersionResource{Group: kind.Group, Version: version}.GroupVersion()
+		if !hasVersion(group, version) {
+			continue
+		}
+		list, err := c.ServerResourcesForGroupVersion(gv)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range list.APIResources {
+			if r.Kind != kind.Kind || strings.Contains(r.Name, "/") {
+				continue
+			}
+			return &k8s.RESTMapping{
+				Resource: k8s.GroupVersionResource{
+					Group:    kind.Group,
+					Version:  version,
+					Resource: r.Name,
+				},
+				GroupVersionKind: k8s.GroupVersionKind{
+					Group:   kind.Group,
+					Version: version,
+					Kind:    kind.Kind,
+				},
+				Namespaced: r.Namespaced,
+			}, nil
+		}
+	}
+	return nil, errors.Errorf("no resource for kind %s in group %q at versions %v", kind.Kind, kind.Group, versions)
+}
+
+func hasVersion(group *k8s.APIGroup, version string) bool {
+	for _, v := range group.Versions {
+		if v.Version == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerGroups(t *testing.T) {
+	c := testClient(t)
+
+	groups, err := c.ServerGroups()
+	require.Nil(t, err)
+	require.True(t, len(groups.Groups) > 1, "should have core and named groups")
+	assert.Equal(t, "", groups.Groups[0].Name)
+	assert.Equal(t, "v1", groups.Groups[0].PreferredVersion.GroupVersion)
+}
+
+func TestServerResourcesForGroupVersion(t *testing.T) {
+	c := testClient(t)
+
+	list, err := c.ServerResourcesForGroupVersion("v1")
+	require.Nil(t, err)
+
+	pods := list.Resource("pods")
+	require.NotNil(t, pods)
+	assert.Equal(t, "Pod", pods.Kind)
+	assert.True(t, pods.Namespaced)
+	assert.True(t, pods.HasVerb("watch"))
+	assert.Contains(t, pods.ShortNames, "po")
+	assert.Contains(t, list.Subresources("pods"), "log")
+
+	nodes := list.Resource("nodes")
+	require.NotNil(t, nodes)
+	assert.False(t, nodes.Namespaced)
+}
+
+func TestServerPreferredResources(t *testing.T) {
+	c := testClient(t)
+
+	lists, err := c.ServerPreferredResources()
+	if client.IsGroupDiscoveryFailedError(err) {
+		t.Logf("some group versions are not available: %v", err)
+	} else {
+		require.Nil(t, err)
+	}
+	require.NotEmpty(t, lists)
+	assert.Equal(t, "v1", lists[0].GroupVersion)
+
+	// the results are copies of the cached ones
+	lists[0].APIResources = nil
+	list, err := c.ServerResourcesForGroupVersion("v1")
+	require.Nil(t, err)
+	assert.NotNil(t, list.Resource("pods"))
+}
+
+func TestRESTMapping(t *testing.T) {
+	c := testClient(t)
+
+	m, err := c.RESTMapping(client.GroupKind{Kind: "ConfigMap"})
+	require.Nil(t, err)
+	assert.Equal(t, "configmaps", m.Resource.Resource)
+	assert.Equal(t, "/api/v1/namespaces/default/configmaps/test", m.Path("default", "test"))
+
+	m, err = c.RESTMapping(client.GroupKind{Group: "apps", Kind: "Deployment"}, "v1")
+	require.Nil(t, err)
+	assert.Equal(t, "/apis/apps/v1/namespaces/default/deployments", m.Path("default", ""))
+
+	_, err = c.RESTMapping(client.GroupKind{Kind: "NoSuchKind"})
+	assert.NotNil(t, err)
+}
+
+func TestDiscoveryCache(t *testing.T) {
+	c := testClient(t, http.SetDiscoveryTTL(0))
+	_, err := c.ServerGroups()
+	require.Nil(t, err)
+	c.InvalidateDiscovery()
+	_, err = c.ServerGroups()
+	require.Nil(t, err)
+}
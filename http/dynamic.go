@@ -0,0 +1,211 @@
+package http
+
+import (
+	"sync"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+type (
+	// dynamicResource is a client for a single resource that works with
+	// Unstructured objects.
+	dynamicResource struct {
+		client   *Client
+		resource k8s.GroupVersionResource
+
+		// namespaced is set once the scope of the resource is discovered.
+		mu         sync.Mutex
+		namespaced *bool
+	}
+
+	watchEventUnstructured struct {
+		raw    k8s.WatchEvent
+		object *k8s.Unstructured
+	}
+)
+
+func (w *watchEventUnstructured) Type() k8s.WatchEventType {
+	return w.raw.Type
+}
+
+func (w *watchEventUnstructured) Object() (*k8s.Unstructured, error) {
+	if w.object != nil {
+		return w.object, nil
+	}
+	if w.raw.Type == k8s.WatchEventTypeError {
+		var status k8s.Status
+		if err := w.raw.UnmarshalObject(&status); err != nil {
+			return nil, errors.Wrap(err, "failed to decode Status")
+		}
+		return nil, &status
+	}
+	var object k8s.Unstructured
+	if err := w.raw.UnmarshalObject(&object); err != nil {
+		return nil, errors.Wrap(err, "failed to decode object")
+	}
+	w.object = &object
+	return &object, nil
+}
+
+// Resource returns a client for the given resource, such as
+// {Group: "apps", Version: "v1", Resource: "statefulsets"}. It can be used for
+// kinds this package does not model, including custom resources.
+func (c *Client) Resource(resource k8s.GroupVersionResource) k8s.DynamicResourceInterface {
+	return &dynamicResource{
+		client:   c,
+		resource: resource,
+	}
+}
+
+// isNamespaced reports whether the resource is namespaced, using discovery
+// the first time it is called.
+func (r *dynamicResource) isNamespaced() (bool, error) {
+	r.mu.Lock()
+	namespaced := r.namespaced
+	r.mu.Unlock()
+	if namespaced != nil {
+		return *namespaced, nil
+	}
+
+	list, err := r.client.ServerResourcesForGroupVersion(r.resource.GroupVersion())
+	if err != nil {
+		return false, err
+	}
+	resource := list.Resource(r.resource.Resource)
+	if resource == nil {
+		return false, errors.Errorf("the server does not have resource %s", r.resource)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.namespaced = &resource.Namespaced
+	return resource.Namespaced, nil
+}
+
+// generatePath returns the path of the collection or of the named object.
+// namespace is dropped for cluster scoped resources.
+func (r *dynamicResource) generatePath(namespace, name string) (string, error) {
+	namespaced, err := r.isNamespaced()
+	if err != nil {
+		return "", err
+	}
+	if !namespaced {
+		namespace = ""
+	}
+	return r.resource.Path(namespace, name), nil
+}
+
+// Get fetches a single object.
+func (r *dynamicResource) Get(namespace, name string) (*k8s.Unstructured, error) {
+	path, err := r.generatePath(namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", r.resource.Resource)
+	}
+	var out k8s.Unstructured
+	if _, err := r.client.do("GET", path, nil, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", r.resource.Resource)
+	}
+	return &out, nil
+}
+
+// Create creates a new object. This will fail if it already exists.
+func (r *dynamicResource) Create(namespace string, item *k8s.Unstructured) (*k8s.Unstructured, error) {
+	namespaced, err := r.isNamespaced()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", r.resource.Resource)
+	}
+	if namespaced {
+		item.SetNamespace(namespace)
+	} else {
+		namespace = ""
+	}
+
+	var out k8s.Unstructured
+	if _, err := r.client.do("POST", r.resource.Path(namespace, ""), item, &out, 201); err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", r.resource.Resource)
+	}
+	return &out, nil
+}
+
+// List lists all objects in a namespace.
+func (r *dynamicResource) List(namespace string, opts *k8s.ListOptions) (*k8s.UnstructuredList, error) {
+	path, err := r.generatePath(namespace, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list %s", r.resource.Resource)
+	}
+	var out k8s.UnstructuredList
+	if _, err := r.client.do("GET", path+"?"+listOptionsQuery(opts, nil), nil, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to list %s", r.resource.Resource)
+	}
+	return &out, nil
+}
+
+// Watch watches all changes to objects in a namespace. events is closed
+// when the watch ends.
+func (r *dynamicResource) Watch(namespace string, opts *k8s.WatchOptions, events chan k8s.UnstructuredWatchEvent) error {
+	if events == nil {
+		return errors.New("events must not be nil")
+	}
+	defer close(events)
+	path, err := r.generatePath(namespace, "")
+	if err != nil {
+		return errors.Wrapf(err, "failed to watch %s", r.resource.Resource)
+	}
+
+	rawEvents := make(chan k8s.WatchEvent)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for rawEvent := range rawEvents {
+			events <- &watchEventUnstructured{raw: rawEvent}
+		}
+	}()
+	_, err = r.client.doWatch("GET", path+"?"+watchOptionsQuery(opts), nil, rawEvents)
+	<-done
+	return errors.Wrapf(err, "failed to watch %s", r.resource.Resource)
+}
+
+// Delete deletes a single object. It will error if the object does not exist.
+func (r *dynamicResource) Delete(namespace, name string) error {
+	path, err := r.generatePath(namespace, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete %s", r.resource.Resource)
+	}
+	_, err = r.client.do("DELETE", path, nil, nil)
+	return errors.Wrapf(err, "failed to delete %s", r.resource.Resource)
+}
+
+// Update will update in place a single object. Generally, you should call
+// Get and then use that object for updates to ensure resource versions
+// avoid update conflicts
+func (r *dynamicResource) Update(namespace string, item *k8s.Unstructured) (*k8s.Unstructured, error) {
+	namespaced, err := r.isNamespaced()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update %s", r.resource.Resource)
+	}
+	if namespaced {
+		item.SetNamespace(namespace)
+	} else {
+		namespace = ""
+	}
+
+	var out k8s.Unstructured
+	if _, err := r.client.do("PUT", r.resource.Path(namespace, item.GetName()), item, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to update %s", r.resource.Resource)
+	}
+	return &out, nil
+}
+
+// Patch applies a patch of the given type to a single object.
+func (r *dynamicResource) Patch(namespace, name string, pt k8s.PatchType, data []byte) (*k8s.Unstructured, error) {
+	path, err := r.generatePath(namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to patch %s", r.resource.Resource)
+	}
+	var out k8s.Unstructured
+	if _, err := r.client.doPatch(path, pt, data, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to patch %s", r.resource.Resource)
+	}
+	return &out, nil
+}
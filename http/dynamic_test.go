@@ -0,0 +1,66 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicResource(t *testing.T) {
+	withTestNamespace(t, func(t *testing.T, c *http.Client, n *client.Namespace) {
+		r := c.Resource(client.GroupVersionResource{Version: "v1", Resource: "configmaps"})
+
+		in := client.NewUnstructured("v1", "ConfigMap", "", "test-dynamic")
+		require.Nil(t, client.SetNestedField(in.Object, map[string]string{"foo": "bar"}, "data"))
+
+		out, err := r.Create(n.Name, in)
+		require.Nil(t, err)
+		assert.Equal(t, "test-dynamic", out.GetName())
+
+		out, err = r.Get(n.Name, in.GetName())
+		require.Nil(t, err)
+		value, found, err := client.NestedString(out.Object, "data", "foo")
+		assert.Nil(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "bar", value)
+
+		list, err := r.List(n.Name, nil)
+		require.Nil(t, err)
+		assert.True(t, len(list.Items) > 0, "should not be empty")
+
+		out, err = r.Patch(n.Name, in.GetName(), client.MergePatchType, []byte(`{"data":{"foo":"baz"}}`))
+		require.Nil(t, err)
+		value, _, _ = client.NestedString(out.Object, "data", "foo")
+		assert.Equal(t, "baz", value)
+
+		out.SetLabels(map[string]string{"app": "test"})
+		out, err = r.Update(n.Name, out)
+		require.Nil(t, err)
+		assert.Equal(t, "test", out.GetLabels()["app"])
+
+		err = r.Delete(n.Name, in.GetName())
+		assert.Nil(t, err)
+
+		_, err = r.Get(n.Name, in.GetName())
+		assert.True(t, client.IsNotFoundError(err))
+	})
+}
+
+func TestDynamicResourceClusterScoped(t *testing.T) {
+	withTestNamespace(t, func(t *testing.T, c *http.Client, n *client.Namespace) {
+		r := c.Resource(client.GroupVersionResource{Version: "v1", Resource: "namespaces"})
+
+		// the namespace is ignored for cluster scoped resources
+		out, err := r.Get("default", n.Name)
+		require.Nil(t, err)
+		assert.Equal(t, n.Name, out.GetName())
+		assert.Equal(t, "", out.GetNamespace())
+
+		list, err := r.List("default", nil)
+		require.Nil(t, err)
+		assert.True(t, len(list.Items) > 0, "should not be empty")
+	})
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+// DeletePodWithOptions deletes a single Pod using the given options, such as a
+// grace period. It will error if the Pod does not exist.
+func (c *Client) DeletePodWithOptions(namespace, name string, opts *k8s.DeleteOptions) error {
+	return c.Pods().DeleteWithOptions(namespace, name, opts)
+}
+
+// EvictPod evicts a single Pod using the eviction subresource. Unlike DeletePod,
+// this honors any PodDisruptionBudgets that cover the Pod. If evicting the Pod
+// would violate a budget, the returned error satisfies k8s.IsTooManyRequestsError
+// and the eviction may be retried later.
+func (c *Client) EvictPod(namespace, name string, opts *k8s.DeleteOptions) error {
+	// Eviction is in the policy group, so it is served at the same versions
+	// as PodDisruptionBudget.
+	groupVersion, err := c.PodDisruptionBudgets().GroupVersion()
+	if err != nil {
+		return errors.Wrap(err, "failed to evict Pod")
+	}
+	path, err := c.Pods().resolvePath(namespace, name)
+	if err != nil {
+		return errors.Wrap(err, "failed to evict Pod")
+	}
+
+	item := k8s.NewEviction(namespace, name)
+	item.APIVersion = groupVersion
+	item.DeleteOptions = opts
+
+	_, err = c.do("POST", path+"/eviction", item, nil, 200, 201)
+	return errors.Wrap(err, "failed to evict Pod")
+}
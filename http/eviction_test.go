@@ -0,0 +1,51 @@
+package http_test
+
+import (
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvictPodNotFound(t *testing.T) {
+	withTestNamespace(t, func(t *testing.T, c *http.Client, n *client.Namespace) {
+		err := c.EvictPod(n.Name, "does-not-exist", nil)
+		assert.NotNil(t, err)
+		assert.True(t, client.IsNotFoundError(err), "should be a not found error")
+	})
+}
+
+func TestEvictPodTooManyRequests(t *testing.T) {
+	var eviction client.Eviction
+	mux := nethttp.NewServeMux()
+	mux.HandleFunc("/api", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_, _ = w.Write([]byte(`{"kind": "APIVersions", "versions": ["v1"]}`))
+	})
+	mux.HandleFunc("/apis", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_, _ = w.Write([]byte(`{"kind": "APIGroupList", "groups": [{"name": "policy", "versions": [{"groupVersion": "policy/v1", "version": "v1"}]}]}`))
+	})
+	mux.HandleFunc("/api/v1/namespaces/default/pods/web/eviction", func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&eviction))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(nethttp.StatusTooManyRequests)
+		_, _ = w.Write([]byte(`{"kind": "Status", "apiVersion": "v1", "status": "Failure", "message": "Cannot evict pod as it would violate the pod's disruption budget.", "reason": "TooManyRequests", "code": 429}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := http.New(http.SetServer(server.URL))
+	require.Nil(t, err)
+
+	err = c.EvictPod("default", "web", nil)
+	require.NotNil(t, err)
+	assert.True(t, client.IsTooManyRequestsError(err), "should be a too many requests error: %v", err)
+	assert.Equal(t, "policy/v1", eviction.APIVersion)
+	assert.Equal(t, "Eviction", eviction.Kind)
+	assert.Equal(t, "web", eviction.Name)
+}
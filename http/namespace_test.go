@@ -1,6 +1,7 @@
 package http_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -14,16 +15,11 @@ import (
 func withTestNamespace(t *testing.T, f func(*testing.T, *http.Client, *client.Namespace)) {
 	c := testClient(t)
 
-	for {
-		// deletion is not immediate, so wait until its gone
-		_, err := c.GetNamespace("test123")
-		// should make sure this is a not found error, but it'll fail later
-		// if its something else
-		if err != nil {
-			break
-		}
-		time.Sleep(1 * time.Second)
-	}
+	// deletion is not immediate, so wait until its gone
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	_, err := client.WaitFor(ctx, client.NamespaceListWatcher(c, "test123"), client.DeletedCondition())
+	require.Nil(t, err)
 
 	ns := client.Namespace{
 		ObjectMeta: client.ObjectMeta{
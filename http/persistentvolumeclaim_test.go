@@ -0,0 +1,36 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersistentVolumeClaimCreate(t *testing.T) {
+	withTestNamespace(t, func(t *testing.T, c *http.Client, n *client.Namespace) {
+		in := client.NewPersistentVolumeClaim(n.Name, "test-claim")
+		in.Spec.AccessModes = []client.PersistentVolumeAccessMode{client.ReadWriteOnce}
+		in.Spec.Resources.Requests = client.ResourceList{client.ResourceStorage: client.MustParse("1Mi")}
+
+		out, err := c.CreatePersistentVolumeClaim(n.Name, in)
+		require.Nil(t, err)
+		assert.NotNil(t, out.Status)
+
+		list, err := c.ListPersistentVolumeClaims(n.Name, nil)
+		require.Nil(t, err)
+		assert.Len(t, list.Items, 1)
+
+		err = c.DeletePersistentVolumeClaim(n.Name, in.Name)
+		assert.Nil(t, err)
+	})
+}
+
+func TestStorageClassList(t *testing.T) {
+	c := testClient(t)
+	list, err := c.ListStorageClasses(nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, list)
+}
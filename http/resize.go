@@ -0,0 +1,30 @@
+package http
+
+import (
+	"encoding/json"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+// ResizePersistentVolumeClaim patches the storage request of a single
+// PersistentVolumeClaim. The StorageClass of the claim must allow volume
+// expansion, and volumes can only grow.
+func (c *Client) ResizePersistentVolumeClaim(namespace, name string, size k8s.Quantity) (*k8s.PersistentVolumeClaim, error) {
+	data, err := json.Marshal(resizePatch(size))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode patch")
+	}
+	out, err := c.PersistentVolumeClaims().Patch(namespace, name, k8s.MergePatchType, data)
+	return out, errors.Wrap(err, "failed to resize PersistentVolumeClaim")
+}
+
+func resizePatch(size k8s.Quantity) map[string]interface{} {
+	return map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": k8s.ResourceList{k8s.ResourceStorage: size},
+			},
+		},
+	}
+}
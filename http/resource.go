@@ -0,0 +1,381 @@
+package http
+
+import (
+	"reflect"
+	"sync"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+type (
+	// ResourceInfo describes a resource so that ResourceClient can reach it.
+	ResourceInfo struct {
+		// Kind of the objects, such as "Deployment".
+		Kind string
+		// Resource is the plural name of the resource, such as "deployments".
+		Resource string
+		// GroupVersions are the group versions the resource is served at, in
+		// order of preference, such as "apps/v1". The first one the server
+		// supports is used. Discovery is only needed if there are several.
+		GroupVersions []string
+		// Namespaced is false for cluster scoped resources such as nodes.
+		Namespaced bool
+	}
+
+	// ResourceClient is a typed client for a single resource. T is the type
+	// of the objects and L the type of lists of them, such as k8s.Pod and
+	// k8s.PodList. *T must embed k8s.TypeMeta and k8s.ObjectMeta, or
+	// otherwise implement resourceObject.
+	//
+	// namespace is ignored for cluster scoped resources. It may be "" to list
+	// or watch across all namespaces.
+	ResourceClient[T any, L any] struct {
+		client *Client
+		info   ResourceInfo
+	}
+
+	// WatchEvent is a watch event for objects of type T.
+	WatchEvent[T any] interface {
+		Type() k8s.WatchEventType
+		Object() (*T, error)
+	}
+
+	watchEvent[T any] struct {
+		raw    k8s.WatchEvent
+		kind   string
+		object *T
+	}
+
+	// resourceObject is implemented by pointers to the kinds of the root
+	// package and to any type that embeds k8s.TypeMeta and k8s.ObjectMeta.
+	resourceObject interface {
+		GetName() string
+		SetKind(kind string)
+		SetAPIVersion(version string)
+		SetNamespace(namespace string)
+	}
+)
+
+var registry = struct {
+	sync.RWMutex
+	resources map[reflect.Type]ResourceInfo
+}{
+	resources: make(map[reflect.Type]ResourceInfo),
+}
+
+// Register records the resource for objects of type T, so that clients for
+// it can be made with ResourceFor. It is typically used for custom resources:
+//
+//	http.Register[CronTab](http.ResourceInfo{
+//		Kind:          "CronTab",
+//		Resource:      "crontabs",
+//		GroupVersions: []string{"stable.example.com/v1"},
+//		Namespaced:    true,
+//	})
+//	crontabs, err := http.ResourceFor[CronTab, CronTabList](client)
+//
+// The kinds of the root package are registered already.
+func Register[T any](info ResourceInfo) error {
+	if err := checkResource[T](info); err != nil {
+		return err
+	}
+	register[T](info)
+	return nil
+}
+
+// RegisterCustomResource records the custom resource defined by crd for
+// objects of type T, so that clients for it can be made with ResourceFor:
+//
+//	crd, err := k8s.WaitForCustomResourceDefinitionEstablished(ctx, client, "crontabs.stable.example.com")
+//	...
+//	err = http.RegisterCustomResource[CronTab](crd)
+//	...
+//	crontabs, err := http.ResourceFor[CronTab, CronTabList](client)
+func RegisterCustomResource[T any](crd *k8s.CustomResourceDefinition) error {
+	info, err := CustomResourceInfo(crd)
+	if err != nil {
+		return err
+	}
+	return Register[T](info)
+}
+
+// CustomResourceInfo returns the description of the custom resource defined
+// by crd. The storage version is preferred over the other served versions.
+func CustomResourceInfo(crd *k8s.CustomResourceDefinition) (ResourceInfo, error) {
+	if crd.Spec == nil {
+		return ResourceInfo{}, errors.Errorf("custom resource definition %q has no spec", crd.Name)
+	}
+	groupVersions := crd.GroupVersions()
+	if len(groupVersions) == 0 {
+		return ResourceInfo{}, errors.Errorf("custom resource definition %q serves no versions", crd.Name)
+	}
+	return ResourceInfo{
+		Kind:          crd.Spec.Names.Kind,
+		Resource:      crd.Spec.Names.Plural,
+		GroupVersions: groupVersions,
+		Namespaced:    crd.Spec.Scope == k8s.NamespaceScoped,
+	}, nil
+}
+
+func register[T any](info ResourceInfo) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.resources[reflect.TypeOf((*T)(nil)).Elem()] = info
+}
+
+// ResourceFor returns a client for the resource registered for T.
+func ResourceFor[T any, L any](c *Client) (*ResourceClient[T, L], error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	registry.RLock()
+	info, ok := registry.resources[t]
+	registry.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("no resource is registered for %s", t)
+	}
+	return newResourceClient[T, L](c, info), nil
+}
+
+// NewResourceClient returns a client for the resource described by info.
+func NewResourceClient[T any, L any](c *Client, info ResourceInfo) (*ResourceClient[T, L], error) {
+	if err := checkResource[T](info); err != nil {
+		return nil, err
+	}
+	return newResourceClient[T, L](c, info), nil
+}
+
+func newResourceClient[T any, L any](c *Client, info ResourceInfo) *ResourceClient[T, L] {
+	return &ResourceClient[T, L]{
+		client: c,
+		info:   info,
+	}
+}
+
+func checkResource[T any](info ResourceInfo) error {
+	if info.Kind == "" || info.Resource == "" || len(info.GroupVersions) == 0 {
+		return errors.New("resource must have a kind, resource and group versions")
+	}
+	if _, ok := interface{}(new(T)).(resourceObject); !ok {
+		return errors.Errorf("%T must embed k8s.TypeMeta and k8s.ObjectMeta", new(T))
+	}
+	return nil
+}
+
+// Info returns the description of the resource.
+func (r *ResourceClient[T, L]) Info() ResourceInfo {
+	return r.info
+}
+
+// GroupVersion returns the group version used for the resource.
+func (r *ResourceClient[T, L]) GroupVersion() (string, error) {
+	return r.client.groupVersionFor(r.info.GroupVersions)
+}
+
+// resolvePath returns the path of the named object, or of the collection if
+// name is empty, at the group version used for the resource.
+func (r *ResourceClient[T, L]) resolvePath(namespace, name string) (string, error) {
+	groupVersion, err := r.GroupVersion()
+	if err != nil {
+		return "", err
+	}
+	return r.path(groupVersion, namespace, name), nil
+}
+
+// path returns the path of the named object, or of the collection if name
+// is empty.
+func (r *ResourceClient[T, L]) path(groupVersion, namespace, name string) string {
+	if !r.info.Namespaced {
+		namespace = ""
+	}
+	path := apiPrefix(groupVersion)
+	if namespace != "" {
+		path += "/namespaces/" + namespace
+	}
+	path += "/" + r.info.Resource
+	if name != "" {
+		path += "/" + name
+	}
+	return path
+}
+
+// prepare sets the kind, apiVersion and namespace of an item before it is sent.
+func (r *ResourceClient[T, L]) prepare(namespace string, item *T) (string, resourceObject, error) {
+	groupVersion, err := r.GroupVersion()
+	if err != nil {
+		return "", nil, err
+	}
+	obj := interface{}(item).(resourceObject)
+	obj.SetKind(r.info.Kind)
+	obj.SetAPIVersion(groupVersion)
+	if r.info.Namespaced {
+		obj.SetNamespace(namespace)
+	}
+	return groupVersion, obj, nil
+}
+
+// Get fetches a single object.
+func (r *ResourceClient[T, L]) Get(namespace, name string) (*T, error) {
+	path, err := r.resolvePath(namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", r.info.Kind)
+	}
+	var out T
+	if _, err := r.client.do("GET", path, nil, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", r.info.Kind)
+	}
+	return &out, nil
+}
+
+// Create creates a new object. This will fail if it already exists.
+func (r *ResourceClient[T, L]) Create(namespace string, item *T) (*T, error) {
+	groupVersion, _, err := r.prepare(namespace, item)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", r.info.Kind)
+	}
+
+	var out T
+	if _, err := r.client.do("POST", r.path(groupVersion, namespace, ""), item, &out, 201); err != nil {
+		return nil, errors.Wrapf(err, "failed to create %s", r.info.Kind)
+	}
+	return &out, nil
+}
+
+// List lists all objects in a namespace.
+func (r *ResourceClient[T, L]) List(namespace string, opts *k8s.ListOptions) (*L, error) {
+	path, err := r.resolvePath(namespace, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list %s", r.info.Resource)
+	}
+	var out L
+	if _, err := r.client.do("GET", path+"?"+listOptionsQuery(opts, nil), nil, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to list %s", r.info.Resource)
+	}
+	return &out, nil
+}
+
+// Watch watches all changes to objects in a namespace. events is closed
+// when the watch ends.
+func (r *ResourceClient[T, L]) Watch(namespace string, opts *k8s.WatchOptions, events chan WatchEvent[T]) error {
+	if events == nil {
+		return errors.New("events must not be nil")
+	}
+	defer close(events)
+	return r.watch(namespace, opts, func(ev *watchEvent[T]) {
+		events <- ev
+	})
+}
+
+// watch calls send for each event until the watch ends.
+func (r *ResourceClient[T, L]) watch(namespace string, opts *k8s.WatchOptions, send func(*watchEvent[T])) error {
+	path, err := r.resolvePath(namespace, "")
+	if err != nil {
+		return errors.Wrapf(err, "failed to watch %s", r.info.Resource)
+	}
+
+	rawEvents := make(chan k8s.WatchEvent)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for rawEvent := range rawEvents {
+			send(&watchEvent[T]{raw: rawEvent, kind: r.info.Kind})
+		}
+	}()
+	_, err = r.client.doWatch("GET", path+"?"+watchOptionsQuery(opts), nil, rawEvents)
+	<-done
+	return errors.Wrapf(err, "failed to watch %s", r.info.Resource)
+}
+
+// Delete deletes a single object. It will error if the object does not exist.
+func (r *ResourceClient[T, L]) Delete(namespace, name string) error {
+	path, err := r.resolvePath(namespace, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete %s", r.info.Kind)
+	}
+	_, err = r.client.do("DELETE", path, nil, nil)
+	return errors.Wrapf(err, "failed to delete %s", r.info.Kind)
+}
+
+// DeleteWithOptions deletes a single object using the given options, such as
+// a grace period or preconditions.
+func (r *ResourceClient[T, L]) DeleteWithOptions(namespace, name string, opts *k8s.DeleteOptions) error {
+	if opts == nil {
+		return r.Delete(namespace, name)
+	}
+	path, err := r.resolvePath(namespace, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete %s", r.info.Kind)
+	}
+	// copy the options so that setting the type does not modify them
+	o := *opts
+	o.TypeMeta = k8s.NewTypeMeta("DeleteOptions", "v1")
+
+	_, err = r.client.do("DELETE", path, &o, nil)
+	return errors.Wrapf(err, "failed to delete %s", r.info.Kind)
+}
+
+// Update will update in place a single object. Generally, you should call
+// Get and then use that object for updates to ensure resource versions
+// avoid update conflicts
+func (r *ResourceClient[T, L]) Update(namespace string, item *T) (*T, error) {
+	groupVersion, obj, err := r.prepare(namespace, item)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update %s", r.info.Kind)
+	}
+
+	var out T
+	if _, err := r.client.do("PUT", r.path(groupVersion, namespace, obj.GetName()), item, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to update %s", r.info.Kind)
+	}
+	return &out, nil
+}
+
+// UpdateStatus updates the status of a single object using the status
+// subresource. The server ignores changes to anything but the status.
+func (r *ResourceClient[T, L]) UpdateStatus(namespace string, item *T) (*T, error) {
+	groupVersion, obj, err := r.prepare(namespace, item)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update %s status", r.info.Kind)
+	}
+
+	var out T
+	if _, err := r.client.do("PUT", r.path(groupVersion, namespace, obj.GetName())+"/status", item, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to update %s status", r.info.Kind)
+	}
+	return &out, nil
+}
+
+// Patch applies a patch of the given type to a single object.
+func (r *ResourceClient[T, L]) Patch(namespace, name string, pt k8s.PatchType, data []byte) (*T, error) {
+	path, err := r.resolvePath(namespace, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to patch %s", r.info.Kind)
+	}
+	var out T
+	if _, err := r.client.doPatch(path, pt, data, &out); err != nil {
+		return nil, errors.Wrapf(err, "failed to patch %s", r.info.Kind)
+	}
+	return &out, nil
+}
+
+func (w *watchEvent[T]) Type() k8s.WatchEventType {
+	return w.raw.Type
+}
+
+func (w *watchEvent[T]) Object() (*T, error) {
+	if w.object != nil {
+		return w.object, nil
+	}
+	if w.raw.Type == k8s.WatchEventTypeError {
+		var status k8s.Status
+		if err := w.raw.UnmarshalObject(&status); err != nil {
+			return nil, errors.Wrap(err, "failed to decode Status")
+		}
+		return nil, &status
+	}
+	var object T
+	if err := w.raw.UnmarshalObject(&object); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s", w.kind)
+	}
+	w.object = &object
+	return &object, nil
+}
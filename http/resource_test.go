@@ -0,0 +1,79 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	settings struct {
+		client.TypeMeta   `json:",inline"`
+		client.ObjectMeta `json:"metadata,omitempty"`
+		Data              map[string]string `json:"data,omitempty"`
+	}
+
+	settingsList struct {
+		client.TypeMeta `json:",inline"`
+		client.ListMeta `json:"metadata,omitempty"`
+		Items           []settings `json:"items"`
+	}
+)
+
+func TestResourceClient(t *testing.T) {
+	require.Nil(t, http.Register[settings](http.ResourceInfo{
+		Kind:          "ConfigMap",
+		Resource:      "configmaps",
+		GroupVersions: []string{"v1"},
+		Namespaced:    true,
+	}))
+
+	withTestNamespace(t, func(t *testing.T, c *http.Client, n *client.Namespace) {
+		r, err := http.ResourceFor[settings, settingsList](c)
+		require.Nil(t, err)
+
+		out, err := r.Create(n.Name, &settings{
+			ObjectMeta: client.ObjectMeta{Name: "test-settings"},
+			Data:       map[string]string{"color": "blue"},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "blue", out.Data["color"])
+
+		list, err := r.List(n.Name, nil)
+		require.Nil(t, err)
+		assert.Len(t, list.Items, 1)
+
+		out, err = r.Patch(n.Name, "test-settings", client.MergePatchType, []byte(`{"data":{"size":"large"}}`))
+		require.Nil(t, err)
+		assert.Equal(t, map[string]string{"color": "blue", "size": "large"}, out.Data)
+
+		typed, err := c.GetConfigMap(n.Name, "test-settings")
+		require.Nil(t, err)
+		assert.Equal(t, "large", typed.Data["size"])
+
+		require.Nil(t, r.Delete(n.Name, "test-settings"))
+		_, err = c.ConfigMaps().Get(n.Name, "test-settings")
+		assert.True(t, client.IsNotFoundError(err))
+	})
+}
+
+func TestNewResourceClient(t *testing.T) {
+	c := testClient(t)
+
+	_, err := http.NewResourceClient[struct{ Name string }, settingsList](c, http.ResourceInfo{
+		Kind:          "ConfigMap",
+		Resource:      "configmaps",
+		GroupVersions: []string{"v1"},
+		Namespaced:    true,
+	})
+	assert.NotNil(t, err, "objects must have metadata")
+
+	_, err = http.NewResourceClient[settings, settingsList](c, http.ResourceInfo{Kind: "ConfigMap"})
+	assert.NotNil(t, err, "resources must be complete")
+
+	_, err = http.ResourceFor[settingsList, settingsList](c)
+	assert.NotNil(t, err, "settingsList is not registered")
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"strings"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+// CreateSelfSubjectAccessReview checks whether the user of the client may
+// make a request. Reviews are not stored, so they cannot be fetched later.
+func (c *Client) CreateSelfSubjectAccessReview(item *k8s.SelfSubjectAccessReview) (*k8s.SelfSubjectAccessReview, error) {
+	item.TypeMeta = k8s.NewTypeMeta("SelfSubjectAccessReview", "authorization.k8s.io/v1")
+
+	var out k8s.SelfSubjectAccessReview
+	_, err := c.do("POST", "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews", item, &out, 200, 201)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create SelfSubjectAccessReview")
+	}
+	return &out, nil
+}
+
+// CreateSubjectAccessReview checks whether any user may make a request.
+func (c *Client) CreateSubjectAccessReview(item *k8s.SubjectAccessReview) (*k8s.SubjectAccessReview, error) {
+	item.TypeMeta = k8s.NewTypeMeta("SubjectAccessReview", "authorization.k8s.io/v1")
+
+	var out k8s.SubjectAccessReview
+	_, err := c.do("POST", "/apis/authorization.k8s.io/v1/subjectaccessreviews", item, &out, 200, 201)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create SubjectAccessReview")
+	}
+	return &out, nil
+}
+
+// CreateTokenReview authenticates a bearer token.
+func (c *Client) CreateTokenReview(item *k8s.TokenReview) (*k8s.TokenReview, error) {
+	item.TypeMeta = k8s.NewTypeMeta("TokenReview", "authentication.k8s.io/v1")
+
+	var out k8s.TokenReview
+	_, err := c.do("POST", "/apis/authentication.k8s.io/v1/tokenreviews", item, &out, 200, 201)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create TokenReview")
+	}
+	return &out, nil
+}
+
+// CanI reports whether the user of the client may perform verb on resource
+// in namespace, like kubectl auth can-i. resource is the plural name of the
+// resource, optionally followed by its group and a subresource, such as
+// "pods", "deployments.apps" or "pods/log". An empty namespace checks all
+// namespaces. Use CreateSelfSubjectAccessReview for the reason a request
+// is not allowed.
+func (c *Client) CanI(verb, resource, namespace string) (bool, error) {
+	attrs := k8s.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      verb,
+		Resource:  resource,
+	}
+	if i := strings.Index(resource, "/"); i >= 0 {
+		attrs.Resource, attrs.Subresource = resource[:i], resource[i+1:]
+	}
+	if i := strings.Index(attrs.Resource, "."); i >= 0 {
+		attrs.Resource, attrs.Group = attrs.Resource[:i], attrs.Resource[i+1:]
+	}
+
+	out, err := c.CreateSelfSubjectAccessReview(k8s.NewSelfSubjectAccessReview(attrs))
+	if err != nil {
+		return false, err
+	}
+	if out.Status == nil {
+		return false, errors.New("access review has no status")
+	}
+	return out.Status.Allowed, nil
+}
@@ -0,0 +1,30 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/bakins/k8s-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanI(t *testing.T) {
+	c := testClient(t)
+
+	allowed, err := c.CanI("list", "pods", "default")
+	require.Nil(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = c.CanI("get", "deployments.apps/scale", "")
+	require.Nil(t, err)
+	assert.True(t, allowed)
+}
+
+func TestCreateTokenReview(t *testing.T) {
+	c := testClient(t)
+
+	review, err := c.CreateTokenReview(client.NewTokenReview("not-a-token"))
+	require.Nil(t, err)
+	require.NotNil(t, review.Status)
+	assert.False(t, review.Status.Authenticated)
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"strings"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+// ServerVersion fetches the version of the API server.
+func (c *Client) ServerVersion() (*k8s.VersionInfo, error) {
+	var out k8s.VersionInfo
+	_, err := c.do("GET", "/version", nil, &out)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get server version")
+	}
+	return &out, nil
+}
+
+// apiPrefix returns the path the group version is served under.
+func apiPrefix(groupVersion string) string {
+	if group, _ := k8s.ParseGroupVersion(groupVersion); group == "" {
+		return "/api/" + groupVersion
+	}
+	return "/apis/" + groupVersion
+}
+
+// groupVersionFor returns the first of groupVersions, which are in order of
+// preference, that the server supports. The result is kept until
+// InvalidateDiscovery is called, so that it is not discovered for each
+// request even if discovery results are not cached.
+func (c *Client) groupVersionFor(groupVersions []string) (string, error) {
+	if len(groupVersions) == 1 {
+		return groupVersions[0], nil
+	}
+
+	key := strings.Join(groupVersions, ",")
+	c.discovery.Lock()
+	gv, ok := c.discovery.groupVersions[key]
+	generation := c.discovery.generation
+	c.discovery.Unlock()
+	if ok {
+		return gv, nil
+	}
+
+	groups, err := c.ServerGroups()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to find which of %v the server supports", groupVersions)
+	}
+	gv = supportedGroupVersion(groups, groupVersions)
+	if gv == "" {
+		return "", errors.Errorf("the server does not support any of %v", groupVersions)
+	}
+
+	c.discovery.Lock()
+	defer c.discovery.Unlock()
+	if c.discovery.generation == generation {
+		if c.discovery.groupVersions == nil {
+			c.discovery.groupVersions = make(map[string]string)
+		}
+		c.discovery.groupVersions[key] = gv
+	}
+	return gv, nil
+}
+
+// supportedGroupVersion returns the first of groupVersions that is in groups,
+// or "" if none are.
+func supportedGroupVersion(groups *k8s.APIGroupList, groupVersions []string) string {
+	for _, gv := range groupVersions {
+		group, version := k8s.ParseGroupVersion(gv)
+		for i := range groups.Groups {
+			if groups.Groups[i].Name == group && hasVersion(&groups.Groups[i], version) {
+				return gv
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,21 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerVersion(t *testing.T) {
+	c := testClient(t)
+
+	info, err := c.ServerVersion()
+	require.Nil(t, err)
+	assert.NotEmpty(t, info.GitVersion)
+
+	v, err := info.Version()
+	require.Nil(t, err)
+	assert.Equal(t, 1, v.Major)
+	assert.True(t, info.AtLeast(1, 0))
+}
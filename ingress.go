@@ -1,21 +1,6 @@
 package client
 
 type (
-	// IngressInterface has methods to work with Ingress resources.
-	IngressInterface interface {
-		CreateIngress(namespace string, item *Ingress) (*Ingress, error)
-		GetIngress(namespace, name string) (result *Ingress, err error)
-		ListIngresses(namespace string, opts *ListOptions) (*IngressList, error)
-		WatchIngresses(namespace string, opts *WatchOptions, events chan IngressWatchEvent) error
-		DeleteIngress(namespace, name string) error
-		UpdateIngress(namespace string, item *Ingress) (*Ingress, error)
-	}
-
-	IngressWatchEvent interface {
-		Type() WatchEventType
-		Object() (*Ingress, error)
-	}
-
 	// Ingress holds secret data of a certain type.
 	Ingress struct {
 		TypeMeta   `json:",inline"`
@@ -25,6 +10,9 @@ type (
 		Spec *IngressSpec `json:"spec,omitempty"`
 		// Status is the current state of the Ingress.
 		Status *IngressStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// IngressList holds a list of ingresses.
@@ -127,3 +115,17 @@ func NewIngress(namespace, name string) *Ingress {
 		Spec:       &IngressSpec{},
 	}
 }
+
+// UnmarshalJSON decodes the Ingress, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (i *Ingress) UnmarshalJSON(data []byte) error {
+	type alias Ingress
+	return unmarshalKeepingUnknown(data, (*alias)(i), &i.raw)
+}
+
+// MarshalJSON encodes the Ingress, including any fields it was decoded with
+// that this package does not model.
+func (i Ingress) MarshalJSON() ([]byte, error) {
+	type alias Ingress
+	return marshalKeepingUnknown(alias(i), i.raw)
+}
@@ -1,21 +1,13 @@
 package client
 
-type (
-	// JobInterface has methods to work with Job resources.
-	JobInterface interface {
-		CreateJob(namespace string, item *Job) (*Job, error)
-		GetJob(namespace, name string) (result *Job, err error)
-		ListJobs(namespace string, opts *ListOptions) (*JobList, error)
-		WatchJobs(namespace string, opts *WatchOptions, events chan JobWatchEvent) error
-		DeleteJob(namespace, name string) error
-		UpdateJob(namespace string, item *Job) (*Job, error)
-	}
-
-	JobWatchEvent interface {
-		Type() WatchEventType
-		Object() (*Job, error)
-	}
+const (
+	// JobComplete means the job has completed its execution.
+	JobComplete = "Complete"
+	// JobFailed means the job has failed its execution.
+	JobFailed = "Failed"
+)
 
+type (
 	// Job represents the configuration of a single job.
 	Job struct {
 		TypeMeta   `json:",inline"`
@@ -26,6 +18,9 @@ type (
 
 		// Most recently observed status of the DaemonSet.
 		Status *JobStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// JobSpec describes how the job execution will look like.
@@ -106,3 +101,17 @@ func NewJob(namespace, name string) *Job {
 		Spec:       &JobSpec{},
 	}
 }
+
+// UnmarshalJSON decodes the Job, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (j *Job) UnmarshalJSON(data []byte) error {
+	type alias Job
+	return unmarshalKeepingUnknown(data, (*alias)(j), &j.raw)
+}
+
+// MarshalJSON encodes the Job, including any fields it was decoded with
+// that this package does not model.
+func (j Job) MarshalJSON() ([]byte, error) {
+	type alias Job
+	return marshalKeepingUnknown(alias(j), j.raw)
+}
@@ -0,0 +1,88 @@
+package client
+
+const (
+	// LimitTypePod is a limit that applies to all containers of a pod together.
+	LimitTypePod LimitType = "Pod"
+	// LimitTypeContainer is a limit that applies to each container.
+	LimitTypeContainer LimitType = "Container"
+	// LimitTypePersistentVolumeClaim is a limit that applies to each
+	// persistent volume claim.
+	LimitTypePersistentVolumeClaim LimitType = "PersistentVolumeClaim"
+)
+
+type (
+	// LimitRange sets resource usage limits for each kind of resource in a
+	// namespace.
+	LimitRange struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec defines the limits enforced.
+		Spec *LimitRangeSpec `json:"spec,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// LimitRangeList is a list of LimitRange items.
+	LimitRangeList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		Items []LimitRange `json:"items"`
+	}
+
+	// LimitRangeSpec defines a min/max usage limit for resources that match
+	// on kind.
+	LimitRangeSpec struct {
+		// Limits is the list of LimitRangeItem objects that are enforced.
+		Limits []LimitRangeItem `json:"limits"`
+	}
+
+	// LimitRangeItem defines a min/max usage limit for any resource that
+	// matches on kind.
+	LimitRangeItem struct {
+		// Type of resource that this limit applies to.
+		Type LimitType `json:"type"`
+		// Max usage constraints on this kind by resource name.
+		Max ResourceList `json:"max,omitempty"`
+		// Min usage constraints on this kind by resource name.
+		Min ResourceList `json:"min,omitempty"`
+		// Default resource limit value by resource name if the resource limit
+		// is omitted.
+		Default ResourceList `json:"default,omitempty"`
+		// DefaultRequest is the default resource requirement request value by
+		// resource name if the resource request is omitted.
+		DefaultRequest ResourceList `json:"defaultRequest,omitempty"`
+		// MaxLimitRequestRatio if specified, the named resource must have a
+		// request and limit that are both non-zero where limit divided by
+		// request is less than or equal to the enumerated value.
+		MaxLimitRequestRatio ResourceList `json:"maxLimitRequestRatio,omitempty"`
+	}
+
+	// LimitType is a type of object that is limited.
+	LimitType string
+)
+
+// NewLimitRange creates a new LimitRange struct
+func NewLimitRange(namespace, name string) *LimitRange {
+	return &LimitRange{
+		TypeMeta:   NewTypeMeta("LimitRange", "v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+		Spec:       &LimitRangeSpec{},
+	}
+}
+
+// UnmarshalJSON decodes the LimitRange, keeping the fields this package does
+// not model so that they are sent back by MarshalJSON.
+func (l *LimitRange) UnmarshalJSON(data []byte) error {
+	type alias LimitRange
+	return unmarshalKeepingUnknown(data, (*alias)(l), &l.raw)
+}
+
+// MarshalJSON encodes the LimitRange, including any fields it was decoded
+// with that this package does not model.
+func (l LimitRange) MarshalJSON() ([]byte, error) {
+	type alias LimitRange
+	return marshalKeepingUnknown(alias(l), l.raw)
+}
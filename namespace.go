@@ -1,20 +1,6 @@
 package client
 
 type (
-	NamespaceInterface interface {
-		CreateNamespace(item *Namespace) (*Namespace, error)
-		GetNamespace(name string) (result *Namespace, err error)
-		ListNamespaces(opts *ListOptions) (*NamespaceList, error)
-		WatchNamespaces(opts *WatchOptions, events chan NamespaceWatchEvent) error
-		DeleteNamespace(name string) error
-		UpdateNamespace(item *Namespace) (*Namespace, error)
-	}
-
-	NamespaceWatchEvent interface {
-		Type() WatchEventType
-		Object() (*Namespace, error)
-	}
-
 	NamespaceSpec struct {
 		Finalizers []FinalizerName
 	}
@@ -30,6 +16,9 @@ type (
 		ObjectMeta `json:"metadata,omitempty"`
 		Spec       *NamespaceSpec   `json:"spec,omitempty"`
 		Status     *NamespaceStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	NamespaceList struct {
@@ -48,3 +37,17 @@ func NewNamespace(name string) *Namespace {
 		Spec:       &NamespaceSpec{},
 	}
 }
+
+// UnmarshalJSON decodes the Namespace, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (n *Namespace) UnmarshalJSON(data []byte) error {
+	type alias Namespace
+	return unmarshalKeepingUnknown(data, (*alias)(n), &n.raw)
+}
+
+// MarshalJSON encodes the Namespace, including any fields it was decoded with
+// that this package does not model.
+func (n Namespace) MarshalJSON() ([]byte, error) {
+	type alias Namespace
+	return marshalKeepingUnknown(alias(n), n.raw)
+}
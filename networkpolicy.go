@@ -0,0 +1,169 @@
+package client
+
+const (
+	// PolicyTypeIngress is a NetworkPolicy that affects ingress traffic on
+	// selected pods.
+	PolicyTypeIngress PolicyType = "Ingress"
+	// PolicyTypeEgress is a NetworkPolicy that affects egress traffic on
+	// selected pods.
+	PolicyTypeEgress PolicyType = "Egress"
+
+	// ProtocolTCP is the TCP protocol.
+	ProtocolTCP Protocol = "TCP"
+	// ProtocolUDP is the UDP protocol.
+	ProtocolUDP Protocol = "UDP"
+	// ProtocolSCTP is the SCTP protocol.
+	ProtocolSCTP Protocol = "SCTP"
+)
+
+type (
+	// NetworkPolicy describes what network traffic is allowed for a set of Pods.
+	NetworkPolicy struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec is the desired behavior for this NetworkPolicy.
+		Spec *NetworkPolicySpec `json:"spec,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// NetworkPolicyList is a list of NetworkPolicy objects.
+	NetworkPolicyList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		Items []NetworkPolicy `json:"items"`
+	}
+
+	// NetworkPolicySpec provides the specification of a NetworkPolicy.
+	NetworkPolicySpec struct {
+		// PodSelector selects the pods to which this NetworkPolicy object
+		// applies. An empty podSelector selects all pods in the namespace.
+		PodSelector LabelSelector `json:"podSelector"`
+		// Ingress is a list of ingress rules to be applied to the selected
+		// pods. Traffic is allowed to a pod if there are no NetworkPolicies
+		// selecting the pod, or if the traffic matches at least one ingress
+		// rule across all of the NetworkPolicy objects whose podSelector
+		// matches the pod.
+		Ingress []NetworkPolicyIngressRule `json:"ingress,omitempty"`
+		// Egress is a list of egress rules to be applied to the selected pods,
+		// with the same semantics as Ingress.
+		Egress []NetworkPolicyEgressRule `json:"egress,omitempty"`
+		// PolicyTypes are the rule types the NetworkPolicy relates to. If not
+		// specified, it defaults to Ingress, plus Egress if the policy has any
+		// egress rules.
+		PolicyTypes []PolicyType `json:"policyTypes,omitempty"`
+	}
+
+	// NetworkPolicyIngressRule describes a particular set of traffic that is
+	// allowed to the pods matched by a NetworkPolicySpec's podSelector. The
+	// traffic must match both ports and from.
+	NetworkPolicyIngressRule struct {
+		// Ports is a list of ports which should be made accessible on the pods
+		// selected for this rule. If empty, the rule matches all ports.
+		Ports []NetworkPolicyPort `json:"ports,omitempty"`
+		// From is a list of sources which should be able to access the pods
+		// selected for this rule. If empty, the rule matches all sources.
+		From []NetworkPolicyPeer `json:"from,omitempty"`
+	}
+
+	// NetworkPolicyEgressRule describes a particular set of traffic that is
+	// allowed out of pods matched by a NetworkPolicySpec's podSelector. The
+	// traffic must match both ports and to.
+	NetworkPolicyEgressRule struct {
+		// Ports is a list of destination ports for outgoing traffic. If empty,
+		// the rule matches all ports.
+		Ports []NetworkPolicyPort `json:"ports,omitempty"`
+		// To is a list of destinations for outgoing traffic of pods selected
+		// for this rule. If empty, the rule matches all destinations.
+		To []NetworkPolicyPeer `json:"to,omitempty"`
+	}
+
+	// NetworkPolicyPort describes a port to allow traffic on.
+	NetworkPolicyPort struct {
+		// Protocol is the protocol which traffic must match. Defaults to TCP.
+		Protocol *Protocol `json:"protocol,omitempty"`
+		// Port is the port on the given protocol. This can either be a
+		// numerical or named port on a pod. If not provided, this matches all
+		// port names and numbers.
+		Port *IntOrString `json:"port,omitempty"`
+		// EndPort indicates that the range of ports from Port to EndPort,
+		// inclusive, should be allowed. It cannot be set if Port is not
+		// numerical.
+		EndPort *int32 `json:"endPort,omitempty"`
+	}
+
+	// NetworkPolicyPeer describes a peer to allow traffic to or from. Only
+	// certain combinations of fields are allowed.
+	NetworkPolicyPeer struct {
+		// PodSelector selects pods. If NamespaceSelector is also set, it
+		// selects the pods matching PodSelector in the namespaces selected by
+		// NamespaceSelector. Otherwise it selects the pods matching PodSelector
+		// in the policy's own namespace.
+		PodSelector *LabelSelector `json:"podSelector,omitempty"`
+		// NamespaceSelector selects namespaces. If PodSelector is also set, it
+		// selects the pods matching PodSelector in the namespaces selected by
+		// NamespaceSelector. Otherwise it selects all pods in the namespaces
+		// selected by NamespaceSelector.
+		NamespaceSelector *LabelSelector `json:"namespaceSelector,omitempty"`
+		// IPBlock defines policy on a particular IPBlock. If this field is
+		// set then neither of the other fields can be.
+		IPBlock *IPBlock `json:"ipBlock,omitempty"`
+	}
+
+	// IPBlock describes a particular CIDR, such as "192.168.1.0/24", that
+	// is allowed to the pods matched by a NetworkPolicySpec's podSelector.
+	// The except entry describes CIDRs that should not be included within
+	// this rule.
+	IPBlock struct {
+		// CIDR is a string representing the IP Block.
+		CIDR string `json:"cidr"`
+		// Except is a slice of CIDRs that should not be included within an
+		// IP Block. Except values will be rejected if they are outside the
+		// CIDR range.
+		Except []string `json:"except,omitempty"`
+	}
+
+	// PolicyType is the type of traffic a NetworkPolicy applies to.
+	PolicyType string
+)
+
+// NewNetworkPolicy creates a new NetworkPolicy struct
+func NewNetworkPolicy(namespace, name string) *NetworkPolicy {
+	return &NetworkPolicy{
+		TypeMeta:   NewTypeMeta("NetworkPolicy", "networking.k8s.io/v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+		Spec:       &NetworkPolicySpec{},
+	}
+}
+
+// PolicyTypes returns the policy types of the NetworkPolicy, applying the
+// same defaults as the API server when none are set.
+func (n *NetworkPolicy) PolicyTypes() []PolicyType {
+	if n.Spec == nil {
+		return []PolicyType{PolicyTypeIngress}
+	}
+	if len(n.Spec.PolicyTypes) > 0 {
+		return n.Spec.PolicyTypes
+	}
+	if len(n.Spec.Egress) > 0 {
+		return []PolicyType{PolicyTypeIngress, PolicyTypeEgress}
+	}
+	return []PolicyType{PolicyTypeIngress}
+}
+
+// UnmarshalJSON decodes the NetworkPolicy, keeping the fields this package
+// does not model so that they are sent back by MarshalJSON.
+func (n *NetworkPolicy) UnmarshalJSON(data []byte) error {
+	type alias NetworkPolicy
+	return unmarshalKeepingUnknown(data, (*alias)(n), &n.raw)
+}
+
+// MarshalJSON encodes the NetworkPolicy, including any fields it was decoded
+// with that this package does not model.
+func (n NetworkPolicy) MarshalJSON() ([]byte, error) {
+	type alias NetworkPolicy
+	return marshalKeepingUnknown(alias(n), n.raw)
+}
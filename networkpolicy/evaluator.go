@@ -0,0 +1,276 @@
+// Package networkpolicy evaluates NetworkPolicies locally, so that the
+// effect of a set of policies on traffic between pods can be checked
+// without sending any traffic.
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+// NamespaceNameLabel is the label the API server sets on every namespace to
+// its name, so that policies can select namespaces by name.
+const NamespaceNameLabel = "kubernetes.io/metadata.name"
+
+type (
+	// Client is the subset of the Kubernetes client used to load policies
+	// and namespaces.
+	Client interface {
+		k8s.NetworkPolicyInterface
+		k8s.NamespaceInterface
+	}
+
+	// Evaluator answers whether traffic between pods is allowed, using a
+	// fixed set of policies and namespaces.
+	Evaluator struct {
+		policies   []k8s.NetworkPolicy
+		namespaces map[string]map[string]string
+	}
+
+	// direction is the side of a connection a policy is evaluated for.
+	direction struct {
+		policyType k8s.PolicyType
+		// local is the pod the policies select and remote the pod at the
+		// other end of the connection.
+		local, remote *k8s.Pod
+	}
+
+	// rule is an ingress or egress rule.
+	rule struct {
+		ports []k8s.NetworkPolicyPort
+		peers []k8s.NetworkPolicyPeer
+	}
+)
+
+// NewEvaluator returns an Evaluator for the policies. The namespaces are
+// used to match namespace selectors. Namespaces that are not given are
+// treated as having only the NamespaceNameLabel label.
+func NewEvaluator(policies []k8s.NetworkPolicy, namespaces []k8s.Namespace) *Evaluator {
+	e := &Evaluator{
+		policies:   policies,
+		namespaces: make(map[string]map[string]string, len(namespaces)),
+	}
+	for i := range namespaces {
+		labels := map[string]string{NamespaceNameLabel: namespaces[i].Name}
+		for k, v := range namespaces[i].Labels {
+			labels[k] = v
+		}
+		e.namespaces[namespaces[i].Name] = labels
+	}
+	return e
+}
+
+// Load returns an Evaluator for all the policies and namespaces in the cluster.
+func Load(c Client) (*Evaluator, error) {
+	policies, err := c.ListNetworkPolicies("", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list network policies")
+	}
+	namespaces, err := c.ListNamespaces(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list namespaces")
+	}
+	return NewEvaluator(policies.Items, namespaces.Items), nil
+}
+
+// Allowed reports whether a connection from one pod to a port of another
+// is allowed. port may be a number or the name of a container port of the
+// destination pod, and protocol defaults to TCP. The connection must be
+// allowed both out of from and into to. reason describes which policies
+// allow or deny it.
+func (e *Evaluator) Allowed(from, to *k8s.Pod, port k8s.IntOrString, protocol k8s.Protocol) (allowed bool, reason string) {
+	if protocol == "" {
+		protocol = k8s.ProtocolTCP
+	}
+	number, ok := resolvePort(to, port, protocol)
+	if !ok {
+		return false, fmt.Sprintf("pod %s has no %s port %q", podName(to), protocol, port.String())
+	}
+
+	egress, egressReason := e.allowed(direction{k8s.PolicyTypeEgress, from, to}, number, protocol)
+	if !egress {
+		return false, egressReason
+	}
+	ingress, ingressReason := e.allowed(direction{k8s.PolicyTypeIngress, to, from}, number, protocol)
+	if !ingress {
+		return false, ingressReason
+	}
+	return true, egressReason + "; " + ingressReason
+}
+
+// Policies returns the policies that select the pod for the policy type.
+// A pod that no policy selects is not isolated for that type of traffic.
+func (e *Evaluator) Policies(pod *k8s.Pod, policyType k8s.PolicyType) []*k8s.NetworkPolicy {
+	var policies []*k8s.NetworkPolicy
+	for i := range e.policies {
+		p := &e.policies[i]
+		if p.Namespace != pod.Namespace || p.Spec == nil || !hasPolicyType(p, policyType) {
+			continue
+		}
+		if p.Spec.PodSelector.Matches(pod.Labels) {
+			policies = append(policies, p)
+		}
+	}
+	return policies
+}
+
+// allowed evaluates the policies that select the local pod of d.
+func (e *Evaluator) allowed(d direction, port int, protocol k8s.Protocol) (bool, string) {
+	policies := e.Policies(d.local, d.policyType)
+	if len(policies) == 0 {
+		return true, fmt.Sprintf("no %s policy selects pod %s", d.policyType, podName(d.local))
+	}
+	for _, p := range policies {
+		for _, rule := range rulesOf(p, d.policyType) {
+			if portsMatch(rule.ports, d.portPod(), port, protocol) && e.peersMatch(rule.peers, p.Namespace, d.remote) {
+				return true, fmt.Sprintf("%s allowed by NetworkPolicy %q in namespace %q", d.policyType, p.Name, p.Namespace)
+			}
+		}
+	}
+	return false, fmt.Sprintf("%s of pod %s is denied by the %d policies that select it", d.policyType, podName(d.local), len(policies))
+}
+
+// portPod returns the pod named ports refer to, which is the destination
+// of the connection.
+func (d direction) portPod() *k8s.Pod {
+	if d.policyType == k8s.PolicyTypeIngress {
+		return d.local
+	}
+	return d.remote
+}
+
+// peersMatch reports whether the pod is one of the peers. An empty list of
+// peers matches every pod.
+func (e *Evaluator) peersMatch(peers []k8s.NetworkPolicyPeer, namespace string, pod *k8s.Pod) bool {
+	if len(peers) == 0 {
+		return true
+	}
+	for _, peer := range peers {
+		if e.peerMatches(&peer, namespace, pod) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Evaluator) peerMatches(peer *k8s.NetworkPolicyPeer, namespace string, pod *k8s.Pod) bool {
+	if peer.IPBlock != nil {
+		return pod.Status != nil && ipBlockContains(peer.IPBlock, pod.Status.PodIP)
+	}
+	if peer.NamespaceSelector != nil {
+		if !peer.NamespaceSelector.Matches(e.namespaceLabels(pod.Namespace)) {
+			return false
+		}
+	} else if pod.Namespace != namespace {
+		return false
+	}
+	return peer.PodSelector == nil || peer.PodSelector.Matches(pod.Labels)
+}
+
+func (e *Evaluator) namespaceLabels(namespace string) map[string]string {
+	if labels, ok := e.namespaces[namespace]; ok {
+		return labels
+	}
+	return map[string]string{NamespaceNameLabel: namespace}
+}
+
+func rulesOf(p *k8s.NetworkPolicy, policyType k8s.PolicyType) []rule {
+	var rules []rule
+	if policyType == k8s.PolicyTypeIngress {
+		for _, r := range p.Spec.Ingress {
+			rules = append(rules, rule{ports: r.Ports, peers: r.From})
+		}
+	} else {
+		for _, r := range p.Spec.Egress {
+			rules = append(rules, rule{ports: r.Ports, peers: r.To})
+		}
+	}
+	return rules
+}
+
+func hasPolicyType(p *k8s.NetworkPolicy, policyType k8s.PolicyType) bool {
+	for _, t := range p.PolicyTypes() {
+		if t == policyType {
+			return true
+		}
+	}
+	return false
+}
+
+// portsMatch reports whether the port is one of ports. An empty list of
+// ports matches every port. Named ports are looked up on pod.
+func portsMatch(ports []k8s.NetworkPolicyPort, pod *k8s.Pod, port int, protocol k8s.Protocol) bool {
+	if len(ports) == 0 {
+		return true
+	}
+	for _, p := range ports {
+		proto := k8s.ProtocolTCP
+		if p.Protocol != nil {
+			proto = *p.Protocol
+		}
+		if proto != protocol {
+			continue
+		}
+		if p.Port == nil {
+			return true
+		}
+		start, ok := resolvePort(pod, *p.Port, protocol)
+		if !ok {
+			continue
+		}
+		end := start
+		if p.EndPort != nil && p.Port.Type == k8s.Int {
+			end = int(*p.EndPort)
+		}
+		if port >= start && port <= end {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePort returns the number of a port, looking named ports up in the
+// containers of the pod.
+func resolvePort(pod *k8s.Pod, port k8s.IntOrString, protocol k8s.Protocol) (int, bool) {
+	if port.Type == k8s.Int {
+		return port.IntValue(), true
+	}
+	if pod.Spec == nil {
+		return 0, false
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			proto := p.Protocol
+			if proto == "" {
+				proto = k8s.ProtocolTCP
+			}
+			if p.Name == port.StrVal && proto == protocol {
+				return p.ContainerPort, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func ipBlockContains(block *k8s.IPBlock, address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	if _, cidr, err := net.ParseCIDR(block.CIDR); err != nil || !cidr.Contains(ip) {
+		return false
+	}
+	for _, except := range block.Except {
+		if _, cidr, err := net.ParseCIDR(except); err == nil && cidr.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func podName(pod *k8s.Pod) string {
+	return pod.Namespace + "/" + pod.Name
+}
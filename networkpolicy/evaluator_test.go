@@ -0,0 +1,127 @@
+package networkpolicy
+
+import (
+	"testing"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPod(namespace, name, ip string, labels map[string]string) *k8s.Pod {
+	pod := &k8s.Pod{ObjectMeta: k8s.NewObjectMeta(namespace, name)}
+	pod.Labels = labels
+	pod.Spec = &k8s.PodSpec{
+		Containers: []k8s.Container{{
+			Name:  "app",
+			Ports: []k8s.ContainerPort{{Name: "http", ContainerPort: 8080}},
+		}},
+	}
+	pod.Status = &k8s.PodStatus{PodIP: ip}
+	return pod
+}
+
+func testObjects() []k8s.Object {
+	web := k8s.NewNamespace("web")
+	web.Labels = map[string]string{"team": "web"}
+	monitoring := k8s.NewNamespace("monitoring")
+	monitoring.Labels = map[string]string{"purpose": "monitoring"}
+
+	// the database only accepts traffic from the api on 5432
+	denyAll := k8s.NewNetworkPolicy("web", "default-deny")
+	denyAll.Spec.PolicyTypes = []k8s.PolicyType{k8s.PolicyTypeIngress}
+
+	db := k8s.NewNetworkPolicy("web", "db")
+	db.Spec.PodSelector = k8s.LabelSelector{MatchLabels: map[string]string{"app": "db"}}
+	pg := k8s.FromInt(5432)
+	db.Spec.Ingress = []k8s.NetworkPolicyIngressRule{{
+		Ports: []k8s.NetworkPolicyPort{{Port: &pg}},
+		From:  []k8s.NetworkPolicyPeer{{PodSelector: &k8s.LabelSelector{MatchLabels: map[string]string{"app": "api"}}}},
+	}}
+
+	// the api accepts http from anywhere in the cluster network and
+	// metrics scrapes from monitoring namespaces
+	api := k8s.NewNetworkPolicy("web", "api")
+	api.Spec.PodSelector = k8s.LabelSelector{MatchLabels: map[string]string{"app": "api"}}
+	http := k8s.FromString("http")
+	metrics := k8s.FromInt(9000)
+	end := int32(9100)
+	api.Spec.Ingress = []k8s.NetworkPolicyIngressRule{
+		{
+			Ports: []k8s.NetworkPolicyPort{{Port: &http}},
+			From:  []k8s.NetworkPolicyPeer{{IPBlock: &k8s.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.9.0.0/16"}}}},
+		},
+		{
+			Ports: []k8s.NetworkPolicyPort{{Port: &metrics, EndPort: &end}},
+			From: []k8s.NetworkPolicyPeer{{NamespaceSelector: &k8s.LabelSelector{
+				MatchExpressions: []k8s.LabelSelectorRequirement{{Key: "purpose", Operator: k8s.LabelSelectorOpIn, Values: []string{"monitoring"}}},
+			}}},
+		},
+	}
+
+	// the batch pods may only talk to the database
+	batch := k8s.NewNetworkPolicy("web", "batch")
+	batch.Spec.PodSelector = k8s.LabelSelector{MatchLabels: map[string]string{"app": "batch"}}
+	batch.Spec.Egress = []k8s.NetworkPolicyEgressRule{{
+		To: []k8s.NetworkPolicyPeer{{PodSelector: &k8s.LabelSelector{MatchLabels: map[string]string{"app": "db"}}}},
+	}}
+
+	return []k8s.Object{web, monitoring, denyAll, db, api, batch}
+}
+
+func TestAllowed(t *testing.T) {
+	c, err := fake.New(testObjects()...)
+	require.Nil(t, err)
+	e, err := Load(c)
+	require.Nil(t, err)
+
+	api := testPod("web", "api", "10.1.0.1", map[string]string{"app": "api"})
+	db := testPod("web", "db", "10.1.0.2", map[string]string{"app": "db"})
+	batch := testPod("web", "batch", "10.1.0.3", map[string]string{"app": "batch"})
+	other := testPod("web", "other", "10.9.0.4", map[string]string{"app": "other"})
+	prometheus := testPod("monitoring", "prometheus", "10.2.0.1", map[string]string{"app": "prometheus"})
+	external := testPod("default", "client", "192.168.0.1", nil)
+
+	for _, tc := range []struct {
+		from, to *k8s.Pod
+		port     k8s.IntOrString
+		protocol k8s.Protocol
+		allowed  bool
+	}{
+		{api, db, k8s.FromInt(5432), "", true},
+		{api, db, k8s.FromInt(5432), k8s.ProtocolUDP, false},
+		{api, db, k8s.FromInt(80), "", false},
+		{batch, db, k8s.FromInt(5432), "", false},
+		{db, api, k8s.FromString("http"), "", true},
+		{db, api, k8s.FromInt(8080), "", true},
+		{other, api, k8s.FromInt(8080), "", false},
+		{external, api, k8s.FromInt(8080), "", false},
+		{prometheus, api, k8s.FromInt(9090), "", true},
+		{prometheus, api, k8s.FromInt(9200), "", false},
+		{api, prometheus, k8s.FromInt(9090), "", true},
+		{batch, api, k8s.FromInt(8080), "", false},
+		{db, batch, k8s.FromString("grpc"), "", false},
+	} {
+		allowed, reason := e.Allowed(tc.from, tc.to, tc.port, tc.protocol)
+		assert.Equal(t, tc.allowed, allowed, "%s -> %s:%s: %s", tc.from.Name, tc.to.Name, tc.port.String(), reason)
+	}
+
+	allowed, reason := e.Allowed(api, db, k8s.FromInt(5432), "")
+	assert.True(t, allowed)
+	assert.Contains(t, reason, `Ingress allowed by NetworkPolicy "db"`)
+}
+
+func TestPolicies(t *testing.T) {
+	e := NewEvaluator(nil, nil)
+	for _, obj := range testObjects() {
+		if p, ok := obj.(*k8s.NetworkPolicy); ok {
+			e.policies = append(e.policies, *p)
+		}
+	}
+
+	batch := testPod("web", "batch", "", map[string]string{"app": "batch"})
+	assert.Len(t, e.Policies(batch, k8s.PolicyTypeIngress), 2)
+	assert.Len(t, e.Policies(batch, k8s.PolicyTypeEgress), 1)
+	assert.Len(t, e.Policies(testPod("default", "client", "", nil), k8s.PolicyTypeIngress), 0)
+}
@@ -1,20 +1,6 @@
 package client
 
 type (
-	NodeInterface interface {
-		CreateNode(item *Node) (*Node, error)
-		GetNode(name string) (result *Node, err error)
-		ListNodes(opts *ListOptions) (*NodeList, error)
-		WatchNodes(opts *WatchOptions, events chan NodeWatchEvent) error
-		DeleteNode(name string) error
-		UpdateNode(item *Node) (*Node, error)
-	}
-
-	NodeWatchEvent interface {
-		Type() WatchEventType
-		Object() (*Node, error)
-	}
-
 	NodeSpec struct {
 		PodCIDR       string `json:"podCIDR,omitempty"`
 		ExternalID    string `json:"externalID,omitempty"`
@@ -74,6 +60,9 @@ type (
 		ObjectMeta `json:"metadata,omitempty"`
 		Spec       NodeSpec   `json:"spec,omitempty"`
 		Status     NodeStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	NodeList struct {
@@ -82,3 +71,17 @@ type (
 		Items    []Node `json:"items"`
 	}
 )
+
+// UnmarshalJSON decodes the Node, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (n *Node) UnmarshalJSON(data []byte) error {
+	type alias Node
+	return unmarshalKeepingUnknown(data, (*alias)(n), &n.raw)
+}
+
+// MarshalJSON encodes the Node, including any fields it was decoded with
+// that this package does not model.
+func (n Node) MarshalJSON() ([]byte, error) {
+	type alias Node
+	return marshalKeepingUnknown(alias(n), n.raw)
+}
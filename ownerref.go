@@ -0,0 +1,83 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// AlreadyOwnedError is returned by SetControllerReference when the object
+	// is already controlled by a different owner.
+	AlreadyOwnedError struct {
+		Object Object
+		Owner  OwnerReference
+	}
+)
+
+func (e *AlreadyOwnedError) Error() string {
+	return fmt.Sprintf("object %s %q is already owned by another %s controller %q",
+		e.Object.GetKind(), e.Object.GetName(), e.Owner.Kind, e.Owner.Name)
+}
+
+// NewControllerRef returns an OwnerReference pointing to the given owner with
+// the controller and blockOwnerDeletion flags set. The owner's kind and
+// apiVersion must be set, which is not the case for the items of a list.
+func NewControllerRef(owner Object) (*OwnerReference, error) {
+	if owner.GetKind() == "" || owner.GetAPIVersion() == "" {
+		return nil, errors.Errorf("owner %q must have kind and apiVersion set", owner.GetName())
+	}
+	if owner.GetName() == "" || owner.GetUID() == "" {
+		return nil, errors.Errorf("owner %s must have name and uid set", owner.GetKind())
+	}
+	t := true
+	return &OwnerReference{
+		APIVersion:         owner.GetAPIVersion(),
+		Kind:               owner.GetKind(),
+		Name:               owner.GetName(),
+		UID:                owner.GetUID(),
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}, nil
+}
+
+// SetControllerReference sets owner as the controller of object. Any existing
+// reference to the owner is replaced. An *AlreadyOwnedError is returned if
+// the object is already controlled by a different owner.
+func SetControllerReference(owner, object Object) error {
+	ref, err := NewControllerRef(owner)
+	if err != nil {
+		return err
+	}
+	if existing := GetControllerOf(object); existing != nil && existing.UID != ref.UID {
+		return &AlreadyOwnedError{Object: object, Owner: *existing}
+	}
+
+	refs := object.GetOwnerReferences()
+	for i := range refs {
+		if refs[i].UID == ref.UID {
+			refs[i] = *ref
+			object.SetOwnerReferences(refs)
+			return nil
+		}
+	}
+	object.SetOwnerReferences(append(refs, *ref))
+	return nil
+}
+
+// GetControllerOf returns a copy of the owner reference of the controller of
+// the object, or nil if it has no controller.
+func GetControllerOf(object Object) *OwnerReference {
+	for _, ref := range object.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller {
+			return &ref
+		}
+	}
+	return nil
+}
+
+// IsControlledBy reports whether owner is the controller of object.
+func IsControlledBy(object, owner Object) bool {
+	ref := GetControllerOf(object)
+	return ref != nil && ref.UID == owner.GetUID()
+}
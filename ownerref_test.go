@@ -0,0 +1,53 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetControllerReference(t *testing.T) {
+	owner := NewReplicaSet("default", "web-1234")
+	owner.UID = "rs-uid"
+	pod := &Pod{ObjectMeta: NewObjectMeta("default", "web-1234-abcde")}
+
+	// owner from a list has no kind
+	list := ReplicaSet{ObjectMeta: owner.ObjectMeta}
+	assert.NotNil(t, SetControllerReference(&list, pod))
+
+	require.Nil(t, SetControllerReference(owner, pod))
+	require.Nil(t, SetControllerReference(owner, pod))
+	require.Len(t, pod.OwnerReferences, 1)
+	assert.True(t, IsControlledBy(pod, owner))
+
+	ref := GetControllerOf(pod)
+	require.NotNil(t, ref)
+	assert.Equal(t, "ReplicaSet", ref.Kind)
+	assert.Equal(t, "web-1234", ref.Name)
+	assert.True(t, *ref.BlockOwnerDeletion)
+
+	other := NewReplicaSet("default", "other")
+	other.UID = "other-uid"
+	assert.False(t, IsControlledBy(pod, other))
+	err := SetControllerReference(other, pod)
+	_, ok := err.(*AlreadyOwnedError)
+	assert.True(t, ok)
+}
+
+func TestObjectMetaRoundTrip(t *testing.T) {
+	in := `{"name":"web","generateName":"web-","uid":"1","deletionGracePeriodSeconds":30,` +
+		`"ownerReferences":[{"apiVersion":"apps/v1","kind":"ReplicaSet","name":"web","uid":"2","controller":true}],` +
+		`"finalizers":["example.com/cleanup"],` +
+		`"managedFields":[{"manager":"kubectl","operation":"Update","fieldsType":"FieldsV1","fieldsV1":{"f:metadata":{}}}]}`
+
+	var meta ObjectMeta
+	require.Nil(t, json.Unmarshal([]byte(in), &meta))
+	assert.Equal(t, []string{"example.com/cleanup"}, meta.Finalizers)
+	assert.Equal(t, int64(30), *meta.DeletionGracePeriodSeconds)
+
+	out, err := json.Marshal(meta)
+	require.Nil(t, err)
+	assert.JSONEq(t, in, string(out))
+}
@@ -0,0 +1,179 @@
+package client
+
+const (
+	// PersistentVolumeReclaimRecycle means the volume will be recycled back
+	// into the pool of unbound persistent volumes on release from its claim.
+	PersistentVolumeReclaimRecycle PersistentVolumeReclaimPolicy = "Recycle"
+	// PersistentVolumeReclaimDelete means the volume will be deleted from
+	// Kubernetes on release from its claim.
+	PersistentVolumeReclaimDelete PersistentVolumeReclaimPolicy = "Delete"
+	// PersistentVolumeReclaimRetain means the volume will be left in its
+	// current phase (Released) for manual reclamation by the administrator.
+	PersistentVolumeReclaimRetain PersistentVolumeReclaimPolicy = "Retain"
+
+	// PersistentVolumeBlock means the volume will not be formatted with a
+	// filesystem and will remain a raw block device.
+	PersistentVolumeBlock PersistentVolumeMode = "Block"
+	// PersistentVolumeFilesystem means the volume will be or is formatted with a filesystem.
+	PersistentVolumeFilesystem PersistentVolumeMode = "Filesystem"
+
+	// VolumePending is used for PersistentVolumes that are not available.
+	VolumePending PersistentVolumePhase = "Pending"
+	// VolumeAvailable is used for PersistentVolumes that are not yet bound.
+	VolumeAvailable PersistentVolumePhase = "Available"
+	// VolumeBound is used for PersistentVolumes that are bound.
+	VolumeBound PersistentVolumePhase = "Bound"
+	// VolumeReleased is used for PersistentVolumes where the bound
+	// PersistentVolumeClaim was deleted. Released volumes must be recycled
+	// before becoming available again.
+	VolumeReleased PersistentVolumePhase = "Released"
+	// VolumeFailed is used for PersistentVolumes that failed to be correctly
+	// recycled or deleted after being released from a claim.
+	VolumeFailed PersistentVolumePhase = "Failed"
+)
+
+type (
+	// PersistentVolume is a storage resource provisioned by an administrator.
+	PersistentVolume struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec defines a specification of a persistent volume owned by the cluster.
+		Spec *PersistentVolumeSpec `json:"spec,omitempty"`
+
+		// Status represents the current information/status for the persistent volume.
+		Status *PersistentVolumeStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// PersistentVolumeSpec is the specification of a persistent volume.
+	PersistentVolumeSpec struct {
+		// A description of the persistent volume's resources and capacity.
+		Capacity ResourceList `json:"capacity,omitempty"`
+		// The actual volume backing the persistent volume.
+		PersistentVolumeSource `json:",inline"`
+		// AccessModes contains all ways the volume can be mounted.
+		AccessModes []PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+		// ClaimRef is part of a bi-directional binding between PersistentVolume and PersistentVolumeClaim.
+		// Expected to be non-nil when bound.
+		ClaimRef *ObjectReference `json:"claimRef,omitempty"`
+		// What happens to a persistent volume when released from its claim.
+		PersistentVolumeReclaimPolicy PersistentVolumeReclaimPolicy `json:"persistentVolumeReclaimPolicy,omitempty"`
+		// Name of StorageClass to which this persistent volume belongs. Empty value
+		// means that this volume does not belong to any StorageClass.
+		StorageClassName string `json:"storageClassName,omitempty"`
+		// A list of mount options, e.g. ["ro", "soft"]. Not validated - mount will
+		// simply fail if one is invalid.
+		MountOptions []string `json:"mountOptions,omitempty"`
+		// volumeMode defines if a volume is intended to be used with a formatted filesystem
+		// or to remain in raw block state.
+		VolumeMode *PersistentVolumeMode `json:"volumeMode,omitempty"`
+		// NodeAffinity defines constraints that limit what nodes this volume can be accessed from.
+		NodeAffinity *VolumeNodeAffinity `json:"nodeAffinity,omitempty"`
+	}
+
+	// PersistentVolumeSource is the source of a persistent volume. Exactly one
+	// of its members must be set.
+	PersistentVolumeSource struct {
+		// HostPath represents a directory on the host. For single node development and testing only.
+		HostPath *HostPathVolumeSource `json:"hostPath,omitempty"`
+		// NFS represents an NFS mount on the host.
+		NFS *NFSVolumeSource `json:"nfs,omitempty"`
+		// Local represents directly-attached storage with node affinity.
+		Local *LocalVolumeSource `json:"local,omitempty"`
+		// CSI represents storage that is handled by an external CSI driver.
+		CSI *CSIPersistentVolumeSource `json:"csi,omitempty"`
+	}
+
+	// Represents an NFS mount that lasts the lifetime of a pod.
+	NFSVolumeSource struct {
+		// Server is the hostname or IP address of the NFS server.
+		Server string `json:"server"`
+		// Path that is exported by the NFS server.
+		Path string `json:"path"`
+		// ReadOnly here will force the NFS export to be mounted with read-only permissions.
+		ReadOnly bool `json:"readOnly,omitempty"`
+	}
+
+	// Local represents directly-attached storage with node affinity.
+	LocalVolumeSource struct {
+		// The full path to the volume on the node. It can be either a directory or block device.
+		Path string `json:"path"`
+		// Filesystem type to mount. Only applies when Path is a block device.
+		FSType *string `json:"fsType,omitempty"`
+	}
+
+	// Represents storage that is managed by an external CSI volume driver.
+	CSIPersistentVolumeSource struct {
+		// Driver is the name of the driver to use for this volume.
+		Driver string `json:"driver"`
+		// VolumeHandle is the unique volume name returned by the CSI volume
+		// plugin’s CreateVolume to refer to the volume on all subsequent calls.
+		VolumeHandle string `json:"volumeHandle"`
+		// Optional: The value to pass to ControllerPublishVolumeRequest. Defaults to false (read/write).
+		ReadOnly bool `json:"readOnly,omitempty"`
+		// Filesystem type to mount, such as "ext4".
+		FSType string `json:"fsType,omitempty"`
+		// Attributes of the volume to publish.
+		VolumeAttributes map[string]string `json:"volumeAttributes,omitempty"`
+	}
+
+	// VolumeNodeAffinity defines constraints that limit what nodes this volume can be accessed from.
+	VolumeNodeAffinity struct {
+		// Required specifies hard node constraints that must be met.
+		Required *NodeSelector `json:"required,omitempty"`
+	}
+
+	// PersistentVolumeStatus is the current status of a persistent volume.
+	PersistentVolumeStatus struct {
+		// Phase indicates if a volume is available, bound to a claim, or released by a claim.
+		Phase PersistentVolumePhase `json:"phase,omitempty"`
+		// A human-readable message indicating details about why the volume is in this state.
+		Message string `json:"message,omitempty"`
+		// Reason is a brief CamelCase string that describes any failure and is meant
+		// for machine parsing and tidy display in the CLI.
+		Reason string `json:"reason,omitempty"`
+	}
+
+	// PersistentVolumeList is a list of PersistentVolume items.
+	PersistentVolumeList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is the list of persistent volumes.
+		Items []PersistentVolume `json:"items"`
+	}
+
+	// PersistentVolumeReclaimPolicy describes a policy for end-of-life maintenance of persistent volumes.
+	PersistentVolumeReclaimPolicy string
+
+	// PersistentVolumeMode describes how a volume is intended to be consumed, either Block or Filesystem.
+	PersistentVolumeMode string
+
+	PersistentVolumePhase string
+)
+
+// NewPersistentVolume creates a new PersistentVolume struct
+func NewPersistentVolume(name string) *PersistentVolume {
+	return &PersistentVolume{
+		TypeMeta:   NewTypeMeta("PersistentVolume", "v1"),
+		ObjectMeta: NewObjectMeta("", name),
+		Spec:       &PersistentVolumeSpec{},
+	}
+}
+
+// UnmarshalJSON decodes the PersistentVolume, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (p *PersistentVolume) UnmarshalJSON(data []byte) error {
+	type alias PersistentVolume
+	return unmarshalKeepingUnknown(data, (*alias)(p), &p.raw)
+}
+
+// MarshalJSON encodes the PersistentVolume, including any fields it was decoded with
+// that this package does not model.
+func (p PersistentVolume) MarshalJSON() ([]byte, error) {
+	type alias PersistentVolume
+	return marshalKeepingUnknown(alias(p), p.raw)
+}
@@ -0,0 +1,147 @@
+package client
+
+import "context"
+
+const (
+	// ReadWriteOnce means the volume can be mounted read-write by a single node.
+	ReadWriteOnce PersistentVolumeAccessMode = "ReadWriteOnce"
+	// ReadOnlyMany means the volume can be mounted read-only by many nodes.
+	ReadOnlyMany PersistentVolumeAccessMode = "ReadOnlyMany"
+	// ReadWriteMany means the volume can be mounted read-write by many nodes.
+	ReadWriteMany PersistentVolumeAccessMode = "ReadWriteMany"
+
+	// ClaimPending is used for claims that are not yet bound.
+	ClaimPending PersistentVolumeClaimPhase = "Pending"
+	// ClaimBound is used for claims that are bound to a volume.
+	ClaimBound PersistentVolumeClaimPhase = "Bound"
+	// ClaimLost is used for claims that lost their underlying volume.
+	ClaimLost PersistentVolumeClaimPhase = "Lost"
+
+	// PersistentVolumeClaimResizing means a user triggered resize of the
+	// claim is in progress on the storage backend.
+	PersistentVolumeClaimResizing PersistentVolumeClaimConditionType = "Resizing"
+	// PersistentVolumeClaimFileSystemResizePending means the storage has
+	// been resized and the file system is waiting for a pod to be resized.
+	PersistentVolumeClaimFileSystemResizePending PersistentVolumeClaimConditionType = "FileSystemResizePending"
+)
+
+type (
+	// PersistentVolumeClaimExpansion has the PersistentVolumeClaim methods that are not generated.
+	PersistentVolumeClaimExpansion interface {
+		// ResizePersistentVolumeClaim patches the storage request of the claim.
+		// The StorageClass of the claim must allow volume expansion, and
+		// volumes can only grow.
+		ResizePersistentVolumeClaim(namespace, name string, size Quantity) (*PersistentVolumeClaim, error)
+	}
+
+	// PersistentVolumeClaim is a user's request for and claim to a persistent volume.
+	PersistentVolumeClaim struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec defines the desired characteristics of a volume requested by a pod author.
+		Spec *PersistentVolumeClaimSpec `json:"spec,omitempty"`
+
+		// Status represents the current information/status of a persistent volume claim.
+		Status *PersistentVolumeClaimStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// PersistentVolumeClaimSpec describes the common attributes of storage devices
+	// and allows a Source for provider-specific attributes.
+	PersistentVolumeClaimSpec struct {
+		// AccessModes contains the desired access modes the volume should have.
+		AccessModes []PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+		// A label query over volumes to consider for binding.
+		Selector *LabelSelector `json:"selector,omitempty"`
+		// Resources represents the minimum resources the volume should have.
+		Resources ResourceRequirements `json:"resources,omitempty"`
+		// VolumeName is the binding reference to the PersistentVolume backing this claim.
+		VolumeName string `json:"volumeName,omitempty"`
+		// Name of the StorageClass required by the claim.
+		StorageClassName *string `json:"storageClassName,omitempty"`
+		// volumeMode defines what type of volume is required by the claim.
+		// Value of Filesystem is implied when not included in claim spec.
+		VolumeMode *PersistentVolumeMode `json:"volumeMode,omitempty"`
+	}
+
+	// PersistentVolumeClaimStatus is the current status of a persistent volume claim.
+	PersistentVolumeClaimStatus struct {
+		// Phase represents the current phase of PersistentVolumeClaim.
+		Phase PersistentVolumeClaimPhase `json:"phase,omitempty"`
+		// AccessModes contains the actual access modes the volume backing the PVC has.
+		AccessModes []PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+		// Represents the actual resources of the underlying volume.
+		Capacity ResourceList `json:"capacity,omitempty"`
+		// Current Condition of persistent volume claim. If underlying persistent
+		// volume is being resized then the Condition will be set to 'Resizing'.
+		Conditions []PersistentVolumeClaimCondition `json:"conditions,omitempty"`
+	}
+
+	// PersistentVolumeClaimCondition contails details about state of pvc
+	PersistentVolumeClaimCondition struct {
+		Type   PersistentVolumeClaimConditionType `json:"type"`
+		Status ConditionStatus                    `json:"status"`
+		// Last time we probed the condition.
+		LastProbeTime Time `json:"lastProbeTime,omitempty"`
+		// Last time the condition transitioned from one status to another.
+		LastTransitionTime Time `json:"lastTransitionTime,omitempty"`
+		// Unique, this should be a short, machine understandable string that gives the reason
+		// for condition's last transition.
+		Reason string `json:"reason,omitempty"`
+		// Human-readable message indicating details about last transition.
+		Message string `json:"message,omitempty"`
+	}
+
+	// PersistentVolumeClaimList is a list of PersistentVolumeClaim items.
+	PersistentVolumeClaimList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is the list of claims.
+		Items []PersistentVolumeClaim `json:"items"`
+	}
+
+	PersistentVolumeAccessMode string
+
+	PersistentVolumeClaimPhase string
+
+	PersistentVolumeClaimConditionType string
+)
+
+// NewPersistentVolumeClaim creates a new PersistentVolumeClaim struct
+func NewPersistentVolumeClaim(namespace, name string) *PersistentVolumeClaim {
+	return &PersistentVolumeClaim{
+		TypeMeta:   NewTypeMeta("PersistentVolumeClaim", "v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+		Spec:       &PersistentVolumeClaimSpec{},
+	}
+}
+
+// WaitForPersistentVolumeClaimBound blocks until the claim is bound to a
+// volume, it is lost, or the context is done. Claims of a StorageClass with
+// the WaitForFirstConsumer binding mode are only bound once a pod using them
+// is scheduled.
+func WaitForPersistentVolumeClaimBound(ctx context.Context, c PersistentVolumeClaimInterface, namespace, name string) (*PersistentVolumeClaim, error) {
+	obj, err := WaitFor(ctx, PersistentVolumeClaimListWatcher(c, namespace, name), PersistentVolumeClaimBoundCondition())
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*PersistentVolumeClaim), nil
+}
+
+// UnmarshalJSON decodes the PersistentVolumeClaim, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (p *PersistentVolumeClaim) UnmarshalJSON(data []byte) error {
+	type alias PersistentVolumeClaim
+	return unmarshalKeepingUnknown(data, (*alias)(p), &p.raw)
+}
+
+// MarshalJSON encodes the PersistentVolumeClaim, including any fields it was decoded with
+// that this package does not model.
+func (p PersistentVolumeClaim) MarshalJSON() ([]byte, error) {
+	type alias PersistentVolumeClaim
+	return marshalKeepingUnknown(alias(p), p.raw)
+}
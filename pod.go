@@ -38,21 +38,20 @@ const (
 	// PodUnknown means that for some reason the state of the pod could not be obtained, typically due
 	// to an error in communicating with the host of the pod.
 	PodUnknown PodPhase = "Unknown"
+	// PodScheduled represents status of the scheduling process for this pod.
+	PodScheduled PodConditionType = "PodScheduled"
+	// PodReady means the pod is able to service requests and should be added to the
+	// load balancing pools of all matching services.
+	PodReady PodConditionType = "Ready"
+	// PodInitialized means that all init containers in the pod have started successfully.
+	PodInitialized PodConditionType = "Initialized"
 )
 
 type (
-	PodInterface interface {
-		CreatePod(namespace string, item *Pod) (*Pod, error)
-		GetPod(namespace, name string) (result *Pod, err error)
-		ListPods(namespace string, opts *ListOptions) (*PodList, error)
-		WatchPods(namespace string, opts *WatchOptions, events chan PodWatchEvent) error
-		DeletePod(namespace, name string) error
-		UpdatePod(namespace string, item *Pod) (*Pod, error)
-	}
-
-	PodWatchEvent interface {
-		Type() WatchEventType
-		Object() (*Pod, error)
+	// PodExpansion has the Pod methods that are not generated.
+	PodExpansion interface {
+		DeletePodWithOptions(namespace, name string, opts *DeleteOptions) error
+		EvictPod(namespace, name string, opts *DeleteOptions) error
 	}
 
 	Pod struct {
@@ -60,6 +59,9 @@ type (
 		ObjectMeta `json:"metadata,omitempty"`
 		Spec       *PodSpec   `json:"spec,omitempty"`
 		Status     *PodStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	PodList struct {
@@ -72,6 +74,10 @@ type (
 	PodSpec struct {
 		// List of volumes that can be mounted by containers belonging to the pod.
 		Volumes []Volume `json:"volumes,omitempty"`
+		// List of initialization containers belonging to the pod. They are run in
+		// order before the containers are started, and each must complete
+		// successfully before the next is started.
+		InitContainers []Container `json:"initContainers,omitempty"`
 		// List of containers belonging to the pod. Containers cannot currently be added or removed.
 		// There must be at least one container in a Pod. Cannot be updated.
 		Containers []Container `json:"containers"`
@@ -116,6 +122,12 @@ type (
 		// If specified, the fully qualified Pod hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
 		// If not specified, the pod will not have a domainname at all.
 		Subdomain string `json:"subdomain,omitempty"`
+		// If specified, indicates the pod's priority. The PriorityClass with the given name must exist.
+		PriorityClassName string `json:"priorityClassName,omitempty"`
+		// Overhead represents the resource overhead associated with running a pod
+		// for a given RuntimeClass. It is set by the RuntimeClass admission
+		// controller and is counted by quotas in addition to the containers.
+		Overhead ResourceList `json:"overhead,omitempty"`
 	}
 
 	PodStatus struct {
@@ -194,6 +206,8 @@ type (
 		EmptyDir *EmptyDirVolumeSource `json:"emptyDir,omitempty"`
 		HostPath *HostPathVolumeSource `json:"hostPath,omitempty"`
 		Secret   *SecretVolumeSource   `json:"secret,omitempty"`
+		// PersistentVolumeClaim mounts the volume bound to a claim in the pod's namespace.
+		PersistentVolumeClaim *PersistentVolumeClaimVolumeSource `json:"persistentVolumeClaim,omitempty"`
 	}
 
 	// Represents an empty directory for a pod. Empty directory volumes support ownership management and SELinux relabeling.
@@ -225,6 +239,15 @@ type (
 		DefaultMode int32 `json:"defaultMode,omitempty"`
 	}
 
+	// References the user's PersistentVolumeClaim in the same namespace.
+	// This volume finds the bound PV and mounts that volume for the pod.
+	PersistentVolumeClaimVolumeSource struct {
+		// ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
+		ClaimName string `json:"claimName"`
+		// Will force the ReadOnly setting in VolumeMounts. Default false.
+		ReadOnly bool `json:"readOnly,omitempty"`
+	}
+
 	// Maps a string key to a path within a volume.
 	KeyToPath struct {
 		// The key to project.
@@ -251,7 +274,7 @@ type (
 		ReadinessProbe         *Probe                `json:"readinessProbe,omitempty"`
 		Lifecycle              *Lifecycle            `json:"lifecycle,omitempty"`
 		TerminationMessagePath string                `json:"terminationMessagePath,omitempty"`
-		ImagePullPolicy        PullPolicy            `json:"imagePullPolicy"`
+		ImagePullPolicy        PullPolicy            `json:"imagePullPolicy,omitempty"`
 		Stdin                  bool                  `json:"stdin,omitempty"`
 		StdinOnce              bool                  `json:"stdinOnce,omitempty"`
 		TTY                    bool                  `json:"tty,omitempty"`
@@ -359,7 +382,7 @@ type (
 	}
 
 	// ResourceList is a set of (resource name, quantity) pairs.
-	ResourceList map[ResourceName]string
+	ResourceList map[ResourceName]Quantity
 
 	// ResourceName is the name identifying various resources in a ResourceList.
 	ResourceName string
@@ -430,3 +453,17 @@ type (
 		ContainerID string `json:"containerID,omitempty"`
 	}
 )
+
+// UnmarshalJSON decodes the Pod, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (p *Pod) UnmarshalJSON(data []byte) error {
+	type alias Pod
+	return unmarshalKeepingUnknown(data, (*alias)(p), &p.raw)
+}
+
+// MarshalJSON encodes the Pod, including any fields it was decoded with
+// that this package does not model.
+func (p Pod) MarshalJSON() ([]byte, error) {
+	type alias Pod
+	return marshalKeepingUnknown(alias(p), p.raw)
+}
@@ -0,0 +1,183 @@
+package client
+
+import "github.com/pkg/errors"
+
+const (
+	// IfHealthyBudgetPodEvictionPolicy allows running pods that are not yet
+	// healthy to be evicted only if the budget is not disrupted.
+	IfHealthyBudgetPodEvictionPolicy UnhealthyPodEvictionPolicyType = "IfHealthyBudget"
+	// AlwaysAllowPodEvictionPolicy allows running pods that are not yet
+	// healthy to be evicted regardless of the budget.
+	AlwaysAllowPodEvictionPolicy UnhealthyPodEvictionPolicyType = "AlwaysAllow"
+)
+
+type (
+	// PodDisruptionBudget is an object to define the max disruption that can
+	// be caused to a collection of pods.
+	PodDisruptionBudget struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Specification of the desired behavior of the PodDisruptionBudget.
+		Spec *PodDisruptionBudgetSpec `json:"spec,omitempty"`
+		// Most recently observed status of the PodDisruptionBudget.
+		Status *PodDisruptionBudgetStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// PodDisruptionBudgetList is a collection of PodDisruptionBudgets.
+	PodDisruptionBudgetList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		Items []PodDisruptionBudget `json:"items"`
+	}
+
+	// PodDisruptionBudgetSpec is a description of a PodDisruptionBudget.
+	PodDisruptionBudgetSpec struct {
+		// An eviction is allowed if at least "minAvailable" pods selected by
+		// "selector" will still be available after the eviction, i.e. even in
+		// the absence of the evicted pod. So for example you can prevent all
+		// voluntary evictions by specifying "100%".
+		MinAvailable *IntOrString `json:"minAvailable,omitempty"`
+		// Label query over pods whose evictions are managed by the disruption
+		// budget. An empty selector selects all pods in the namespace.
+		Selector *LabelSelector `json:"selector,omitempty"`
+		// An eviction is allowed if at most "maxUnavailable" pods selected by
+		// "selector" are unavailable after the eviction, i.e. even in absence
+		// of the evicted pod. For example, one can prevent all voluntary
+		// evictions by specifying 0. This is a mutually exclusive setting
+		// with "minAvailable".
+		MaxUnavailable *IntOrString `json:"maxUnavailable,omitempty"`
+		// UnhealthyPodEvictionPolicy defines the criteria for when unhealthy
+		// pods should be considered for eviction.
+		UnhealthyPodEvictionPolicy *UnhealthyPodEvictionPolicyType `json:"unhealthyPodEvictionPolicy,omitempty"`
+	}
+
+	// PodDisruptionBudgetStatus represents information about the status of
+	// a PodDisruptionBudget. Status may trail the actual state of a system.
+	PodDisruptionBudgetStatus struct {
+		// Most recent generation observed when updating this PDB status.
+		ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+		// DisruptedPods contains information about pods whose eviction was
+		// processed by the API server eviction subresource handler but has
+		// not yet been observed by the PodDisruptionBudget controller.
+		DisruptedPods map[string]Time `json:"disruptedPods,omitempty"`
+		// Number of pod disruptions that are currently allowed.
+		DisruptionsAllowed int32 `json:"disruptionsAllowed"`
+		// current number of healthy pods
+		CurrentHealthy int32 `json:"currentHealthy"`
+		// minimum desired number of healthy pods
+		DesiredHealthy int32 `json:"desiredHealthy"`
+		// total number of pods counted by this disruption budget
+		ExpectedPods int32 `json:"expectedPods"`
+	}
+
+	// UnhealthyPodEvictionPolicyType defines the criteria for when unhealthy
+	// pods should be considered for eviction.
+	UnhealthyPodEvictionPolicyType string
+)
+
+// NewPodDisruptionBudget creates a new PodDisruptionBudget struct
+func NewPodDisruptionBudget(namespace, name string) *PodDisruptionBudget {
+	return &PodDisruptionBudget{
+		TypeMeta:   NewTypeMeta("PodDisruptionBudget", "policy/v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+		Spec:       &PodDisruptionBudgetSpec{},
+	}
+}
+
+// ComputeStatus computes the status the disruption controller would give
+// the PodDisruptionBudget, given the pods its selector matches. Pods are
+// healthy if they are Ready and not being deleted. Pods listed in the
+// DisruptedPods of the current status have been evicted already, so they
+// are not counted as healthy.
+//
+// The controller counts the replicas of the controllers of the pods as the
+// expected pods when maxUnavailable or a percentage is used. Here the number
+// of pods given is used instead, so pods that have not been created yet are
+// not counted.
+func (p *PodDisruptionBudget) ComputeStatus(pods []Pod) (*PodDisruptionBudgetStatus, error) {
+	status := &PodDisruptionBudgetStatus{
+		ObservedGeneration: p.Generation,
+		ExpectedPods:       int32(len(pods)),
+	}
+	var disrupted map[string]Time
+	if p.Status != nil {
+		disrupted = p.Status.DisruptedPods
+	}
+	for i := range pods {
+		if _, ok := disrupted[pods[i].Name]; ok {
+			continue
+		}
+		if pods[i].DeletionTimestamp == nil && isPodReady(&pods[i]) {
+			status.CurrentHealthy++
+		}
+	}
+
+	var spec PodDisruptionBudgetSpec
+	if p.Spec != nil {
+		spec = *p.Spec
+	}
+	switch {
+	case spec.MaxUnavailable != nil:
+		maxUnavailable, err := GetValueFromIntOrPercent(spec.MaxUnavailable, len(pods), true)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid maxUnavailable")
+		}
+		status.DesiredHealthy = status.ExpectedPods - int32(maxUnavailable)
+		if status.DesiredHealthy < 0 {
+			status.DesiredHealthy = 0
+		}
+	case spec.MinAvailable != nil:
+		minAvailable, err := GetValueFromIntOrPercent(spec.MinAvailable, len(pods), true)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid minAvailable")
+		}
+		status.DesiredHealthy = int32(minAvailable)
+	}
+
+	if status.CurrentHealthy > status.DesiredHealthy {
+		status.DisruptionsAllowed = status.CurrentHealthy - status.DesiredHealthy
+	}
+	return status, nil
+}
+
+// DisruptionsAllowed returns the number of the pods that may be disrupted
+// without violating the PodDisruptionBudget, given the pods its selector
+// matches. See ComputeStatus.
+func (p *PodDisruptionBudget) DisruptionsAllowed(pods []Pod) (int32, error) {
+	status, err := p.ComputeStatus(pods)
+	if err != nil {
+		return 0, err
+	}
+	return status.DisruptionsAllowed, nil
+}
+
+func isPodReady(pod *Pod) bool {
+	if pod.Status == nil {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == PodReady && c.Status == ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON decodes the PodDisruptionBudget, keeping the fields this
+// package does not model so that they are sent back by MarshalJSON.
+func (p *PodDisruptionBudget) UnmarshalJSON(data []byte) error {
+	type alias PodDisruptionBudget
+	return unmarshalKeepingUnknown(data, (*alias)(p), &p.raw)
+}
+
+// MarshalJSON encodes the PodDisruptionBudget, including any fields it was
+// decoded with that this package does not model.
+func (p PodDisruptionBudget) MarshalJSON() ([]byte, error) {
+	type alias PodDisruptionBudget
+	return marshalKeepingUnknown(alias(p), p.raw)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisruptionsAllowed(t *testing.T) {
+	var pods []Pod
+	for i := 0; i < 5; i++ {
+		pod := Pod{ObjectMeta: NewObjectMeta("default", fmt.Sprintf("web-%d", i)), Status: &PodStatus{}}
+		// web-3 is not ready yet
+		if i != 3 {
+			pod.Status.Conditions = []PodCondition{{Type: PodReady, Status: ConditionTrue}}
+		}
+		pods = append(pods, pod)
+	}
+	// web-4 is being deleted
+	pods[4].DeletionTimestamp = &Time{}
+
+	intOrString := func(v IntOrString) *IntOrString { return &v }
+	for _, tc := range []struct {
+		minAvailable, maxUnavailable *IntOrString
+		desired, allowed             int32
+	}{
+		{nil, nil, 0, 3},
+		{intOrString(FromInt(2)), nil, 2, 1},
+		{intOrString(FromInt(3)), nil, 3, 0},
+		{intOrString(FromInt(4)), nil, 4, 0},
+		{intOrString(FromString("50%")), nil, 3, 0},
+		{intOrString(FromString("40%")), nil, 2, 1},
+		{nil, intOrString(FromInt(1)), 4, 0},
+		{nil, intOrString(FromInt(3)), 2, 1},
+		{nil, intOrString(FromString("30%")), 3, 0},
+		{nil, intOrString(FromString("100%")), 0, 3},
+	} {
+		pdb := NewPodDisruptionBudget("default", "web")
+		pdb.Spec.MinAvailable = tc.minAvailable
+		pdb.Spec.MaxUnavailable = tc.maxUnavailable
+
+		status, err := pdb.ComputeStatus(pods)
+		require.Nil(t, err)
+		assert.Equal(t, int32(5), status.ExpectedPods)
+		assert.Equal(t, int32(3), status.CurrentHealthy)
+		assert.Equal(t, tc.desired, status.DesiredHealthy, "%+v", tc)
+
+		allowed, err := pdb.DisruptionsAllowed(pods)
+		require.Nil(t, err)
+		assert.Equal(t, tc.allowed, allowed, "%+v", tc)
+	}
+
+	// web-0 was evicted, which the controller has not observed yet
+	pdb := NewPodDisruptionBudget("default", "web")
+	pdb.Spec.MinAvailable = intOrString(FromInt(2))
+	pdb.Status = &PodDisruptionBudgetStatus{DisruptedPods: map[string]Time{"web-0": {}}}
+	status, err := pdb.ComputeStatus(pods)
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), status.CurrentHealthy)
+	assert.Equal(t, int32(0), status.DisruptionsAllowed)
+
+	pdb = NewPodDisruptionBudget("default", "web")
+	pdb.Spec.MinAvailable = intOrString(FromString("half"))
+	_, err = pdb.DisruptionsAllowed(pods)
+	assert.NotNil(t, err)
+}
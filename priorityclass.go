@@ -0,0 +1,20 @@
+package client
+
+// The PriorityClass types are generated from the OpenAPI document in
+// zz_generated_types.go.
+
+const (
+	// PreemptLowerPriority lets pods of the class preempt pods of lower priority.
+	PreemptLowerPriority = "PreemptLowerPriority"
+	// PreemptNever keeps pods of the class from preempting other pods.
+	PreemptNever = "Never"
+)
+
+// NewPriorityClass creates a new PriorityClass struct
+func NewPriorityClass(name string, value int32) *PriorityClass {
+	return &PriorityClass{
+		TypeMeta:   NewTypeMeta("PriorityClass", "scheduling.k8s.io/v1"),
+		ObjectMeta: NewObjectMeta("", name),
+		Value:      value,
+	}
+}
@@ -0,0 +1,426 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Values of Quantity.Format
+const (
+	// DecimalExponent is e.g., 12e6
+	DecimalExponent Format = "DecimalExponent"
+	// BinarySI is e.g., 12Mi (12 * 2^20)
+	BinarySI Format = "BinarySI"
+	// DecimalSI is e.g., 12M (12 * 10^6)
+	DecimalSI Format = "DecimalSI"
+)
+
+// maxExponent bounds the exponent accepted by ParseQuantity so that a
+// malicious value cannot make us allocate huge numbers.
+const maxExponent = 100
+
+var (
+	// ErrFormatWrong is returned when a quantity does not match the expected format.
+	ErrFormatWrong = errors.New("quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'")
+	// ErrSuffix is returned when a quantity has an unknown suffix.
+	ErrSuffix = errors.New("unable to parse quantity's suffix")
+
+	// nanosPerUnit is the number of internal units in one whole unit.
+	nanosPerUnit = big.NewInt(1000000000)
+
+	binarySuffixes = []string{"", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+
+	// decimalSuffixes maps exponents to their DecimalSI suffix.
+	decimalSuffixes = map[int]string{
+		-9: "n",
+		-6: "u",
+		-3: "m",
+		0:  "",
+		3:  "k",
+		6:  "M",
+		9:  "G",
+		12: "T",
+		15: "P",
+		18: "E",
+	}
+)
+
+type (
+	// Format lists the three possible formattings of a quantity.
+	Format string
+
+	// Quantity is a fixed-point representation of a number, such as "500m"
+	// CPU or "1Gi" of memory. It provides convenient marshaling and
+	// unmarshaling in JSON in addition to exact arithmetic.
+	//
+	// The serialization format is:
+	//
+	//   <quantity>        ::= <signedNumber><suffix>
+	//   <digit>           ::= 0 | 1 | ... | 9
+	//   <digits>          ::= <digit> | <digit><digits>
+	//   <number>          ::= <digits> | <digits>.<digits> | <digits>. | .<digits>
+	//   <sign>            ::= "+" | "-"
+	//   <signedNumber>    ::= <number> | <sign><number>
+	//   <suffix>          ::= <binarySI> | <decimalExponent> | <decimalSI>
+	//   <binarySI>        ::= Ki | Mi | Gi | Ti | Pi | Ei
+	//   <decimalSI>       ::= n | u | m | "" | k | M | G | T | P | E
+	//   <decimalExponent> ::= "e" <signedNumber> | "E" <signedNumber>
+	//
+	// Values are kept exactly down to nano units (10^-9); anything smaller is
+	// rounded up, away from zero. Quantities are serialized in canonical form,
+	// in the same way as the API server: the format of the parsed value is
+	// preserved, no fractional digits are emitted and the suffix or exponent
+	// is as large as possible. For example, "1.5" is serialized as "1500m"
+	// and "1.5Gi" as "1536Mi". A BinarySI value that is less than 1024 or is
+	// not a whole number is serialized in DecimalSI.
+	Quantity struct {
+		// nanos is the value in units of 10^-9. nil means zero.
+		nanos *big.Int
+		// Format is the format used when serializing the quantity.
+		Format Format
+	}
+)
+
+// ParseQuantity turns str into a Quantity, or returns an error.
+func ParseQuantity(str string) (Quantity, error) {
+	if len(str) == 0 {
+		return Quantity{}, ErrFormatWrong
+	}
+	if str == "0" {
+		return Quantity{Format: DecimalSI}, nil
+	}
+
+	positive, digits, fraction, suffix, err := parseQuantityString(str)
+	if err != nil {
+		return Quantity{}, err
+	}
+
+	base, exponent, format, err := parseSuffix(suffix)
+	if err != nil {
+		return Quantity{}, err
+	}
+
+	// the value is mantissa * 10^-len(fraction) * base^exponent
+	mantissa, ok := new(big.Int).SetString(digits+fraction, 10)
+	if !ok {
+		return Quantity{}, ErrFormatWrong
+	}
+	if !positive {
+		mantissa.Neg(mantissa)
+	}
+
+	var scale int
+	if base == 2 {
+		mantissa.Lsh(mantissa, uint(exponent))
+		scale = 9 - len(fraction)
+	} else {
+		scale = 9 + exponent - len(fraction)
+	}
+	return Quantity{nanos: shift10(mantissa, scale), Format: format}, nil
+}
+
+// MustParse turns the given string into a quantity or panics. It is useful
+// for tests and constants.
+func MustParse(str string) Quantity {
+	q, err := ParseQuantity(str)
+	if err != nil {
+		panic(errors.Wrapf(err, "cannot parse %q", str))
+	}
+	return q
+}
+
+// NewQuantity returns a new Quantity representing the given value in the
+// given format.
+func NewQuantity(value int64, format Format) *Quantity {
+	n := big.NewInt(value)
+	return &Quantity{nanos: n.Mul(n, nanosPerUnit), Format: format}
+}
+
+// NewMilliQuantity returns a new Quantity representing the given value * 1/1000
+// in the given format.
+func NewMilliQuantity(value int64, format Format) *Quantity {
+	n := big.NewInt(value)
+	return &Quantity{nanos: n.Mul(n, big.NewInt(1000000)), Format: format}
+}
+
+// parseQuantityString splits str into its sign, whole digits, fractional
+// digits and suffix.
+func parseQuantityString(str string) (positive bool, digits, fraction, suffix string, err error) {
+	positive = true
+	pos := 0
+	switch str[0] {
+	case '-':
+		positive = false
+		pos++
+	case '+':
+		pos++
+	}
+
+	start := pos
+	for pos < len(str) && str[pos] >= '0' && str[pos] <= '9' {
+		pos++
+	}
+	digits = str[start:pos]
+
+	if pos < len(str) && str[pos] == '.' {
+		pos++
+		start = pos
+		for pos < len(str) && str[pos] >= '0' && str[pos] <= '9' {
+			pos++
+		}
+		fraction = str[start:pos]
+	}
+	if len(digits) == 0 && len(fraction) == 0 {
+		return false, "", "", "", ErrFormatWrong
+	}
+	return positive, digits, fraction, str[pos:], nil
+}
+
+// parseSuffix returns the base and exponent the suffix stands for.
+func parseSuffix(suffix string) (base, exponent int, format Format, err error) {
+	for i, s := range binarySuffixes {
+		if s != "" && s == suffix {
+			return 2, i * 10, BinarySI, nil
+		}
+	}
+	for e, s := range decimalSuffixes {
+		if s == suffix {
+			return 10, e, DecimalSI, nil
+		}
+	}
+	if len(suffix) > 1 && (suffix[0] == 'e' || suffix[0] == 'E') {
+		e, err := strconv.Atoi(suffix[1:])
+		if err != nil {
+			return 0, 0, "", ErrSuffix
+		}
+		if e > maxExponent || e < -maxExponent {
+			return 0, 0, "", errors.Errorf("exponent %d is out of range", e)
+		}
+		return 10, e, DecimalExponent, nil
+	}
+	return 0, 0, "", ErrSuffix
+}
+
+// shift10 returns n * 10^scale. When scale is negative, the result is rounded
+// away from zero.
+func shift10(n *big.Int, scale int) *big.Int {
+	if scale >= 0 {
+		return n.Mul(n, pow10(scale))
+	}
+	return divUp(n, pow10(-scale))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// divUp returns n / d rounded away from zero.
+func divUp(n, d *big.Int) *big.Int {
+	q, m := new(big.Int).QuoRem(n, d, new(big.Int))
+	if m.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	} else if m.Sign() < 0 {
+		q.Sub(q, big.NewInt(1))
+	}
+	return q
+}
+
+func (q *Quantity) value() *big.Int {
+	if q.nanos == nil {
+		return new(big.Int)
+	}
+	return q.nanos
+}
+
+// IsZero returns true if the quantity is equal to zero.
+func (q *Quantity) IsZero() bool {
+	return q.nanos == nil || q.nanos.Sign() == 0
+}
+
+// Sign returns 0 if the quantity is zero, -1 if the quantity is less than zero,
+// or 1 if the quantity is greater than zero.
+func (q *Quantity) Sign() int {
+	return q.value().Sign()
+}
+
+// Cmp returns 0 if the quantity is equal to y, -1 if the quantity is less
+// than y, or 1 if the quantity is greater than y.
+func (q *Quantity) Cmp(y Quantity) int {
+	return q.value().Cmp(y.value())
+}
+
+// CmpInt64 returns 0 if the quantity is equal to y, -1 if the quantity is
+// less than y, or 1 if the quantity is greater than y.
+func (q *Quantity) CmpInt64(y int64) int {
+	return q.Cmp(*NewQuantity(y, ""))
+}
+
+// Add adds the provided y quantity to the current value. If the current value
+// is zero, the format of y is used.
+func (q *Quantity) Add(y Quantity) {
+	if q.Format == "" {
+		q.Format = y.Format
+	}
+	q.nanos = new(big.Int).Add(q.value(), y.value())
+}
+
+// Sub subtracts the provided quantity from the current value in place. If the
+// current value is zero, the format of y is used.
+func (q *Quantity) Sub(y Quantity) {
+	if q.Format == "" {
+		q.Format = y.Format
+	}
+	q.nanos = new(big.Int).Sub(q.value(), y.value())
+}
+
+// Neg sets the quantity to be the negative value of itself.
+func (q *Quantity) Neg() {
+	q.nanos = new(big.Int).Neg(q.value())
+}
+
+// Copy returns a copy of the quantity that does not share any state.
+func (q Quantity) Copy() Quantity {
+	if q.nanos != nil {
+		q.nanos = new(big.Int).Set(q.nanos)
+	}
+	return q
+}
+
+// Value returns the value of q rounded up, away from zero. Values that do
+// not fit in an int64 are clamped.
+func (q *Quantity) Value() int64 {
+	return clampInt64(divUp(q.value(), nanosPerUnit))
+}
+
+// MilliValue returns the value of q * 1000 rounded up, away from zero.
+// Values that do not fit in an int64 are clamped.
+func (q *Quantity) MilliValue() int64 {
+	return clampInt64(divUp(q.value(), big.NewInt(1000000)))
+}
+
+func clampInt64(n *big.Int) int64 {
+	if n.IsInt64() {
+		return n.Int64()
+	}
+	if n.Sign() > 0 {
+		return math.MaxInt64
+	}
+	return math.MinInt64
+}
+
+// String formats the Quantity in canonical form.
+func (q *Quantity) String() string {
+	n := q.value()
+	if n.Sign() == 0 {
+		return "0"
+	}
+
+	format := q.Format
+	if format == BinarySI {
+		whole, rem := new(big.Int).QuoRem(n, nanosPerUnit, new(big.Int))
+		if rem.Sign() != 0 || new(big.Int).Abs(whole).Cmp(big.NewInt(1024)) < 0 {
+			format = DecimalSI
+		} else {
+			return formatBinary(whole)
+		}
+	}
+	return formatDecimal(n, format)
+}
+
+// formatBinary formats a whole number using the largest binary suffix that
+// leaves a whole mantissa.
+func formatBinary(n *big.Int) string {
+	mantissa := new(big.Int).Set(n)
+	i := 0
+	for i < len(binarySuffixes)-1 {
+		next, rem := new(big.Int).QuoRem(mantissa, big.NewInt(1024), new(big.Int))
+		if rem.Sign() != 0 {
+			break
+		}
+		mantissa = next
+		i++
+	}
+	return mantissa.String() + binarySuffixes[i]
+}
+
+// formatDecimal formats nanos using the largest exponent, a multiple of 3,
+// that leaves a whole mantissa.
+func formatDecimal(nanos *big.Int, format Format) string {
+	mantissa := new(big.Int).Set(nanos)
+	exponent := -9
+	thousand := big.NewInt(1000)
+	for format != DecimalSI || exponent < 18 {
+		next, rem := new(big.Int).QuoRem(mantissa, thousand, new(big.Int))
+		if rem.Sign() != 0 {
+			break
+		}
+		mantissa = next
+		exponent += 3
+	}
+
+	if format == DecimalExponent {
+		if exponent == 0 {
+			return mantissa.String()
+		}
+		return mantissa.String() + "e" + strconv.Itoa(exponent)
+	}
+	return mantissa.String() + decimalSuffixes[exponent]
+}
+
+// MarshalJSON implements the json.Marshaller interface.
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface.
+func (q *Quantity) UnmarshalJSON(value []byte) error {
+	value = bytes.TrimSpace(value)
+	if string(value) == "null" {
+		*q = Quantity{}
+		return nil
+	}
+
+	str := string(value)
+	if len(value) > 0 && value[0] == '"' {
+		if err := json.Unmarshal(value, &str); err != nil {
+			return err
+		}
+	}
+
+	parsed, err := ParseQuantity(strings.TrimSpace(str))
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
+
+// Cpu returns the CPU limit if specified.
+func (rl ResourceList) Cpu() *Quantity {
+	return rl.quantity(ResourceCPU, DecimalSI)
+}
+
+// Memory returns the Memory limit if specified.
+func (rl ResourceList) Memory() *Quantity {
+	return rl.quantity(ResourceMemory, BinarySI)
+}
+
+// Storage returns the Storage limit if specified.
+func (rl ResourceList) Storage() *Quantity {
+	return rl.quantity(ResourceStorage, BinarySI)
+}
+
+// quantity returns a copy of the named quantity, or zero in the given format.
+func (rl ResourceList) quantity(name ResourceName, format Format) *Quantity {
+	if q, ok := rl[name]; ok {
+		q = q.Copy()
+		return &q
+	}
+	return &Quantity{Format: format}
+}
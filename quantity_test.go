@@ -0,0 +1,100 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuantityCanonical(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"0", "0"},
+		{"0.0", "0"},
+		{"1", "1"},
+		{"1.5", "1500m"},
+		{"500m", "500m"},
+		{"0.1", "100m"},
+		{"1000m", "1"},
+		{"1Ki", "1Ki"},
+		{"1024", "1024"},
+		{"1024Mi", "1Gi"},
+		{"1.5Gi", "1536Mi"},
+		{"0.5Ki", "512"},
+		{"1.5Ki", "1536"},
+		{"1000k", "1M"},
+		{"12e6", "12e6"},
+		{"1E3", "1e3"},
+		{"1E", "1E"},
+		{"-5m", "-5m"},
+		{"+3k", "3k"},
+		{".5", "500m"},
+		{"5.", "5"},
+		{"100n", "100n"},
+		{"1.1n", "2n"},
+		{"1u", "1u"},
+	}
+	for _, test := range tests {
+		q, err := ParseQuantity(test.in)
+		require.Nil(t, err, test.in)
+		assert.Equal(t, test.out, q.String(), test.in)
+	}
+}
+
+func TestParseQuantityInvalid(t *testing.T) {
+	for _, in := range []string{"", "-", ".", "1.2.3", "1Zi", "1e", "1KiB", "e3", "1e1000"} {
+		_, err := ParseQuantity(in)
+		assert.NotNil(t, err, in)
+	}
+}
+
+func TestQuantityArithmetic(t *testing.T) {
+	q := MustParse("500m")
+	q.Add(MustParse("1.5"))
+	assert.Equal(t, "2", q.String())
+	assert.Equal(t, int64(2000), q.MilliValue())
+
+	q.Sub(MustParse("2500m"))
+	assert.Equal(t, "-500m", q.String())
+	assert.Equal(t, -1, q.Sign())
+
+	mem := MustParse("1Gi")
+	assert.Equal(t, 0, mem.Cmp(MustParse("1024Mi")))
+	assert.Equal(t, 1, mem.Cmp(MustParse("1G")))
+	assert.Equal(t, int64(1073741824), mem.Value())
+
+	// values are rounded up
+	small := MustParse("1m")
+	assert.Equal(t, int64(1), small.Value())
+
+	// Add does not modify the argument
+	a := MustParse("1")
+	b := a.Copy()
+	b.Add(MustParse("1"))
+	assert.Equal(t, "1", a.String())
+	assert.Equal(t, "2", b.String())
+
+	var zero Quantity
+	assert.True(t, zero.IsZero())
+	zero.Add(MustParse("1Ki"))
+	assert.Equal(t, BinarySI, zero.Format)
+	assert.Equal(t, "1Ki", zero.String())
+}
+
+func TestQuantityJSON(t *testing.T) {
+	var r ResourceRequirements
+	err := json.Unmarshal([]byte(`{"limits":{"cpu":"1.5","memory":"1024Mi"},"requests":{"cpu":2}}`), &r)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1500), r.Limits.Cpu().MilliValue())
+	assert.Equal(t, "1Gi", r.Limits.Memory().String())
+	assert.Equal(t, int64(2), r.Requests.Cpu().Value())
+	assert.True(t, r.Requests.Memory().IsZero())
+
+	data, err := json.Marshal(r)
+	require.Nil(t, err)
+	assert.Equal(t, `{"limits":{"cpu":"1500m","memory":"1Gi"},"requests":{"cpu":"2"}}`, string(data))
+}
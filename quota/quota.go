@@ -0,0 +1,384 @@
+// Package quota predicts the compute resource usage of namespaces, so that
+// new workloads can be checked against ResourceQuotas before they are
+// submitted.
+package quota
+
+import (
+	"fmt"
+	"sort"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Client is the subset of the Kubernetes client used to load the
+	// quotas, limit ranges and pods of a namespace.
+	Client interface {
+		k8s.PodInterface
+		k8s.ResourceQuotaInterface
+		k8s.LimitRangeInterface
+	}
+
+	// Namespace holds the quotas, limit ranges and pods of a namespace.
+	// Pods added with AddPods are counted as if they had been created.
+	Namespace struct {
+		Name        string
+		Quotas      []k8s.ResourceQuota
+		LimitRanges []k8s.LimitRange
+		// Pods are the specs of the pods that use resources in the
+		// namespace, with the defaults of LimitRanges applied.
+		Pods []*k8s.PodSpec
+	}
+
+	// Exceeded describes a quota limit that the usage of a namespace exceeds.
+	Exceeded struct {
+		// Quota is the name of the ResourceQuota.
+		Quota    string
+		Resource k8s.ResourceName
+		Hard     k8s.Quantity
+		Used     k8s.Quantity
+	}
+)
+
+// Load returns the quotas, limit ranges and running pods of a namespace.
+func Load(c Client, namespace string) (*Namespace, error) {
+	quotas, err := c.ListResourceQuotas(namespace, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list resource quotas")
+	}
+	limitRanges, err := c.ListLimitRanges(namespace, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list limit ranges")
+	}
+	pods, err := c.ListPods(namespace, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pods")
+	}
+
+	n := &Namespace{
+		Name:        namespace,
+		Quotas:      quotas.Items,
+		LimitRanges: limitRanges.Items,
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		// pods that have finished no longer use compute resources
+		if pod.Spec == nil || (pod.Status != nil && (pod.Status.Phase == k8s.PodSucceeded || pod.Status.Phase == k8s.PodFailed)) {
+			continue
+		}
+		n.Pods = append(n.Pods, ApplyDefaults(pod.Spec, n.LimitRanges))
+	}
+	return n, nil
+}
+
+// CheckDeployment reports which quotas of the namespace of the deployment
+// would be exceeded if all of its replicas were created. Pods that already
+// exist are counted too, so it is meant for deployments that have not been
+// created yet.
+func CheckDeployment(c Client, d *k8s.Deployment) ([]Exceeded, error) {
+	if d.Spec == nil || d.Spec.Template.Spec == nil {
+		return nil, errors.Errorf("deployment %q has no pod template", d.Name)
+	}
+	n, err := Load(c, d.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	replicas := d.Spec.Replicas
+	if replicas == 0 {
+		// the API server defaults a missing replica count to one
+		replicas = 1
+	}
+	n.AddPods(d.Spec.Template.Spec, replicas)
+	return n.Exceeded(), nil
+}
+
+// AddPods counts count pods with the given spec, with the defaults of the
+// LimitRanges of the namespace applied. The spec is not modified.
+func (n *Namespace) AddPods(spec *k8s.PodSpec, count int) {
+	spec = ApplyDefaults(spec, n.LimitRanges)
+	for i := 0; i < count; i++ {
+		n.Pods = append(n.Pods, spec)
+	}
+}
+
+// Usage returns the usage of the pods the quota applies to. A nil quota
+// applies to all pods. The usage is empty if there are no such pods.
+func (n *Namespace) Usage(quota *k8s.ResourceQuota) k8s.ResourceList {
+	usage := k8s.ResourceList{}
+	for _, spec := range n.Pods {
+		if quota != nil && !inScope(quota.Spec, spec) {
+			continue
+		}
+		for name, q := range PodUsage(spec) {
+			total := usage[name]
+			total.Add(q)
+			usage[name] = total
+		}
+	}
+	return usage
+}
+
+// Exceeded returns the quota limits that the usage of the namespace
+// exceeds. Only limits on pods and their CPU and memory are checked.
+func (n *Namespace) Exceeded() []Exceeded {
+	var exceeded []Exceeded
+	for i := range n.Quotas {
+		quota := &n.Quotas[i]
+		if quota.Spec == nil {
+			continue
+		}
+		usage := n.Usage(quota)
+		for _, name := range sortedNames(quota.Spec.Hard) {
+			used, ok := usage[name]
+			hard := quota.Spec.Hard[name]
+			if ok && used.Cmp(hard) > 0 {
+				exceeded = append(exceeded, Exceeded{Quota: quota.Name, Resource: name, Hard: hard, Used: used})
+			}
+		}
+	}
+	return exceeded
+}
+
+func (e Exceeded) String() string {
+	return fmt.Sprintf("exceeded quota %s: %s used %s, limited to %s", e.Quota, e.Resource, e.Used.String(), e.Hard.String())
+}
+
+// ApplyDefaults returns a copy of the spec with the default requests and
+// limits of the LimitRanges set on containers and init containers that do
+// not specify them, as the API server does when a pod is created. Missing
+// requests default to the limit. The spec is not modified.
+func ApplyDefaults(spec *k8s.PodSpec, limitRanges []k8s.LimitRange) *k8s.PodSpec {
+	out := *spec
+	out.Containers = applyContainerDefaults(spec.Containers, limitRanges)
+	if spec.InitContainers != nil {
+		out.InitContainers = applyContainerDefaults(spec.InitContainers, limitRanges)
+	}
+	return &out
+}
+
+func applyContainerDefaults(containers []k8s.Container, limitRanges []k8s.LimitRange) []k8s.Container {
+	out := make([]k8s.Container, len(containers))
+	for i, c := range containers {
+		resources := k8s.ResourceRequirements{
+			Limits:   k8s.ResourceList{},
+			Requests: k8s.ResourceList{},
+		}
+		if c.Resources != nil {
+			copyMissing(resources.Limits, c.Resources.Limits)
+			copyMissing(resources.Requests, c.Resources.Requests)
+		}
+		// requests default to the limits of the container before any
+		// defaults of LimitRanges are applied
+		copyMissing(resources.Requests, resources.Limits)
+		for _, lr := range limitRanges {
+			if lr.Spec == nil {
+				continue
+			}
+			for _, item := range lr.Spec.Limits {
+				if item.Type != k8s.LimitTypeContainer {
+					continue
+				}
+				limits, requests := itemDefaults(&item)
+				copyMissing(resources.Limits, limits)
+				copyMissing(resources.Requests, requests)
+			}
+		}
+		copyMissing(resources.Requests, resources.Limits)
+		c.Resources = &resources
+		out[i] = c
+	}
+	return out
+}
+
+// itemDefaults returns the default limits and requests of a LimitRangeItem,
+// defaulting them like the API server does when the LimitRange is created:
+// limits to the maximum, and requests to the limits and then the minimum.
+// LimitRanges fetched from the server have these defaults already.
+func itemDefaults(item *k8s.LimitRangeItem) (limits, requests k8s.ResourceList) {
+	limits = k8s.ResourceList{}
+	requests = k8s.ResourceList{}
+	copyMissing(limits, item.Default)
+	copyMissing(limits, item.Max)
+	copyMissing(requests, item.DefaultRequest)
+	copyMissing(requests, limits)
+	copyMissing(requests, item.Min)
+	return limits, requests
+}
+
+// PodUsage returns the quota resources a pod with the spec uses: one pod,
+// and the CPU and memory requests and limits of its containers. As init
+// containers run one at a time before the containers, the usage is the sum
+// of the containers or the largest init container, whichever is larger. The
+// overhead of the pod is added to the requests, and to the limits that are
+// set.
+func PodUsage(spec *k8s.PodSpec) k8s.ResourceList {
+	requestsCPU, requestsMemory := podTotal(spec, func(r *k8s.ResourceRequirements) k8s.ResourceList { return r.Requests })
+	limitsCPU, limitsMemory := podTotal(spec, func(r *k8s.ResourceRequirements) k8s.ResourceList { return r.Limits })
+
+	if spec.Overhead != nil {
+		requestsCPU.Add(*spec.Overhead.Cpu())
+		requestsMemory.Add(*spec.Overhead.Memory())
+		if !limitsCPU.IsZero() {
+			limitsCPU.Add(*spec.Overhead.Cpu())
+		}
+		if !limitsMemory.IsZero() {
+			limitsMemory.Add(*spec.Overhead.Memory())
+		}
+	}
+	return k8s.ResourceList{
+		k8s.ResourcePods:           *k8s.NewQuantity(1, k8s.DecimalSI),
+		k8s.ResourceCPU:            requestsCPU.Copy(),
+		k8s.ResourceMemory:         requestsMemory.Copy(),
+		k8s.ResourceRequestsCPU:    requestsCPU,
+		k8s.ResourceRequestsMemory: requestsMemory,
+		k8s.ResourceLimitsCPU:      limitsCPU,
+		k8s.ResourceLimitsMemory:   limitsMemory,
+	}
+}
+
+// podTotal returns the CPU and memory of the pod in the lists that list
+// returns: the sum over the containers, or the largest init container if
+// that is larger.
+func podTotal(spec *k8s.PodSpec, list func(*k8s.ResourceRequirements) k8s.ResourceList) (cpu, memory k8s.Quantity) {
+	var lists []k8s.ResourceList
+	for _, c := range spec.Containers {
+		if c.Resources != nil {
+			lists = append(lists, list(c.Resources))
+		}
+	}
+	cpu, memory = sum(lists)
+	for _, c := range spec.InitContainers {
+		if c.Resources == nil {
+			continue
+		}
+		l := list(c.Resources)
+		if q := l.Cpu(); q.Cmp(cpu) > 0 {
+			cpu = q.Copy()
+		}
+		if q := l.Memory(); q.Cmp(memory) > 0 {
+			memory = q.Copy()
+		}
+	}
+	return cpu, memory
+}
+
+func sum(lists []k8s.ResourceList) (cpu, memory k8s.Quantity) {
+	cpu = k8s.Quantity{Format: k8s.DecimalSI}
+	memory = k8s.Quantity{Format: k8s.BinarySI}
+	for _, l := range lists {
+		cpu.Add(*l.Cpu())
+		memory.Add(*l.Memory())
+	}
+	return cpu, memory
+}
+
+// inScope reports whether a pod with the spec matches the scopes and scope
+// selector of a quota.
+func inScope(quota *k8s.ResourceQuotaSpec, spec *k8s.PodSpec) bool {
+	for _, scope := range quota.Scopes {
+		if !scopeMatches(scope, spec) {
+			return false
+		}
+	}
+	if quota.ScopeSelector == nil {
+		return true
+	}
+	for _, r := range quota.ScopeSelector.MatchExpressions {
+		if r.ScopeName == k8s.ResourceQuotaScopePriorityClass {
+			if !priorityClassMatches(r, spec.PriorityClassName) {
+				return false
+			}
+			continue
+		}
+		matches := scopeMatches(r.ScopeName, spec)
+		switch r.Operator {
+		case k8s.ScopeSelectorOpExists, k8s.ScopeSelectorOpIn:
+		case k8s.ScopeSelectorOpDoesNotExist, k8s.ScopeSelectorOpNotIn:
+			matches = !matches
+		default:
+			matches = false
+		}
+		if !matches {
+			return false
+		}
+	}
+	return true
+}
+
+func scopeMatches(scope k8s.ResourceQuotaScope, spec *k8s.PodSpec) bool {
+	switch scope {
+	case k8s.ResourceQuotaScopeTerminating:
+		return spec.ActiveDeadlineSeconds != nil && *spec.ActiveDeadlineSeconds >= 0
+	case k8s.ResourceQuotaScopeNotTerminating:
+		return spec.ActiveDeadlineSeconds == nil || *spec.ActiveDeadlineSeconds < 0
+	case k8s.ResourceQuotaScopeBestEffort:
+		return isBestEffort(spec)
+	case k8s.ResourceQuotaScopeNotBestEffort:
+		return !isBestEffort(spec)
+	case k8s.ResourceQuotaScopePriorityClass:
+		return spec.PriorityClassName != ""
+	}
+	return false
+}
+
+func priorityClassMatches(r k8s.ScopedResourceSelectorRequirement, name string) bool {
+	switch r.Operator {
+	case k8s.ScopeSelectorOpIn:
+		return contains(r.Values, name)
+	case k8s.ScopeSelectorOpNotIn:
+		return !contains(r.Values, name)
+	case k8s.ScopeSelectorOpExists:
+		return name != ""
+	case k8s.ScopeSelectorOpDoesNotExist:
+		return name == ""
+	}
+	return false
+}
+
+// isBestEffort reports whether no container or init container requests or
+// limits CPU or memory.
+func isBestEffort(spec *k8s.PodSpec) bool {
+	containers := append(append([]k8s.Container{}, spec.Containers...), spec.InitContainers...)
+	for _, c := range containers {
+		if c.Resources == nil {
+			continue
+		}
+		for _, l := range []k8s.ResourceList{c.Resources.Requests, c.Resources.Limits} {
+			if _, ok := l[k8s.ResourceCPU]; ok {
+				return false
+			}
+			if _, ok := l[k8s.ResourceMemory]; ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// copyMissing copies the quantities of from that are not in to.
+func copyMissing(to, from k8s.ResourceList) {
+	for name, q := range from {
+		if _, ok := to[name]; !ok {
+			to[name] = q.Copy()
+		}
+	}
+}
+
+func sortedNames(l k8s.ResourceList) []k8s.ResourceName {
+	names := make([]k8s.ResourceName, 0, len(l))
+	for name := range l {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package quota
+
+import (
+	"testing"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPod(name string, resources *k8s.ResourceRequirements) *k8s.Pod {
+	return &k8s.Pod{
+		ObjectMeta: k8s.NewObjectMeta("team", name),
+		Spec: &k8s.PodSpec{
+			Containers: []k8s.Container{{Name: "app", Image: "app", Resources: resources}},
+		},
+	}
+}
+
+func quantity(l k8s.ResourceList, name k8s.ResourceName) string {
+	q := l[name]
+	return q.String()
+}
+
+func testObjects() []k8s.Object {
+	compute := k8s.NewResourceQuota("team", "compute")
+	compute.Spec.Hard = k8s.ResourceList{
+		k8s.ResourcePods:         k8s.MustParse("10"),
+		k8s.ResourceRequestsCPU:  k8s.MustParse("2"),
+		k8s.ResourceLimitsMemory: k8s.MustParse("3Gi"),
+		"services":               k8s.MustParse("1"),
+	}
+	batch := k8s.NewResourceQuota("team", "batch")
+	batch.Spec.Hard = k8s.ResourceList{k8s.ResourcePods: k8s.MustParse("1")}
+	batch.Spec.Scopes = []k8s.ResourceQuotaScope{k8s.ResourceQuotaScopeTerminating}
+
+	defaults := k8s.NewLimitRange("team", "defaults")
+	defaults.Spec.Limits = []k8s.LimitRangeItem{
+		{Type: k8s.LimitTypePersistentVolumeClaim, Max: k8s.ResourceList{k8s.ResourceStorage: k8s.MustParse("10Gi")}},
+		{
+			Type:           k8s.LimitTypeContainer,
+			Default:        k8s.ResourceList{k8s.ResourceMemory: k8s.MustParse("512Mi")},
+			DefaultRequest: k8s.ResourceList{k8s.ResourceCPU: k8s.MustParse("250m")},
+		},
+	}
+
+	running := testPod("running", &k8s.ResourceRequirements{
+		Limits: k8s.ResourceList{k8s.ResourceCPU: k8s.MustParse("1"), k8s.ResourceMemory: k8s.MustParse("1Gi")},
+	})
+	done := testPod("done", &k8s.ResourceRequirements{
+		Requests: k8s.ResourceList{k8s.ResourceCPU: k8s.MustParse("4")},
+	})
+	done.Status = &k8s.PodStatus{Phase: k8s.PodSucceeded}
+
+	return []k8s.Object{compute, batch, defaults, running, done}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	spec := &k8s.PodSpec{Containers: []k8s.Container{
+		{Name: "a"},
+		{Name: "b", Resources: &k8s.ResourceRequirements{
+			Limits: k8s.ResourceList{k8s.ResourceCPU: k8s.MustParse("1")},
+		}},
+	}}
+	ranges := []k8s.LimitRange{*testObjects()[2].(*k8s.LimitRange)}
+
+	out := ApplyDefaults(spec, ranges)
+	assert.Nil(t, spec.Containers[0].Resources)
+	assert.Len(t, spec.Containers[1].Resources.Requests, 0)
+
+	a := out.Containers[0].Resources
+	assert.Equal(t, "250m", a.Requests.Cpu().String())
+	assert.Equal(t, "512Mi", a.Requests.Memory().String())
+	assert.Equal(t, "512Mi", a.Limits.Memory().String())
+	_, ok := a.Limits[k8s.ResourceCPU]
+	assert.False(t, ok)
+
+	// the request defaults to the limit of the container itself
+	b := out.Containers[1].Resources
+	assert.Equal(t, "1", b.Requests.Cpu().String())
+	assert.Equal(t, "512Mi", b.Limits.Memory().String())
+
+	usage := PodUsage(out)
+	assert.Equal(t, "1250m", usage.Cpu().String())
+	assert.Equal(t, "1250m", quantity(usage, k8s.ResourceRequestsCPU))
+	assert.Equal(t, "1", quantity(usage, k8s.ResourceLimitsCPU))
+	assert.Equal(t, "1Gi", quantity(usage, k8s.ResourceLimitsMemory))
+	assert.Equal(t, "1", quantity(usage, k8s.ResourcePods))
+}
+
+func TestApplyDefaultsInitContainers(t *testing.T) {
+	spec := &k8s.PodSpec{
+		InitContainers: []k8s.Container{{Name: "init"}},
+		Containers:     []k8s.Container{{Name: "app"}},
+	}
+	minimum := k8s.NewLimitRange("team", "minimum")
+	minimum.Spec.Limits = []k8s.LimitRangeItem{{
+		Type: k8s.LimitTypeContainer,
+		Min:  k8s.ResourceList{k8s.ResourceCPU: k8s.MustParse("100m")},
+		Max:  k8s.ResourceList{k8s.ResourceMemory: k8s.MustParse("1Gi")},
+	}}
+
+	out := ApplyDefaults(spec, []k8s.LimitRange{*minimum})
+	assert.Nil(t, spec.InitContainers[0].Resources)
+	for _, c := range []k8s.Container{out.InitContainers[0], out.Containers[0]} {
+		// the request defaults to the minimum and the limit to the maximum
+		assert.Equal(t, "100m", c.Resources.Requests.Cpu().String(), c.Name)
+		assert.Equal(t, "1Gi", c.Resources.Limits.Memory().String(), c.Name)
+		assert.Equal(t, "1Gi", c.Resources.Requests.Memory().String(), c.Name)
+	}
+}
+
+func TestPodUsageInitContainers(t *testing.T) {
+	resources := func(cpu, memory, memoryLimit string) *k8s.ResourceRequirements {
+		return &k8s.ResourceRequirements{
+			Requests: k8s.ResourceList{k8s.ResourceCPU: k8s.MustParse(cpu), k8s.ResourceMemory: k8s.MustParse(memory)},
+			Limits:   k8s.ResourceList{k8s.ResourceMemory: k8s.MustParse(memoryLimit)},
+		}
+	}
+	spec := &k8s.PodSpec{
+		InitContainers: []k8s.Container{
+			{Name: "migrate", Resources: resources("2", "128Mi", "2Gi")},
+			{Name: "warm", Resources: resources("100m", "768Mi", "768Mi")},
+		},
+		Containers: []k8s.Container{
+			{Name: "a", Resources: resources("500m", "256Mi", "512Mi")},
+			{Name: "b", Resources: resources("500m", "256Mi", "512Mi")},
+		},
+	}
+
+	// each resource is the larger of the sum of the containers and the
+	// largest init container
+	usage := PodUsage(spec)
+	assert.Equal(t, "2", quantity(usage, k8s.ResourceRequestsCPU))
+	assert.Equal(t, "768Mi", quantity(usage, k8s.ResourceRequestsMemory))
+	assert.Equal(t, "2Gi", quantity(usage, k8s.ResourceLimitsMemory))
+
+	// overhead is added to the requests, and to the limits that are set
+	spec.Overhead = k8s.ResourceList{k8s.ResourceCPU: k8s.MustParse("100m"), k8s.ResourceMemory: k8s.MustParse("64Mi")}
+	usage = PodUsage(spec)
+	assert.Equal(t, "2100m", quantity(usage, k8s.ResourceRequestsCPU))
+	assert.Equal(t, "2100m", usage.Cpu().String())
+	assert.Equal(t, "832Mi", quantity(usage, k8s.ResourceRequestsMemory))
+	assert.Equal(t, "2112Mi", quantity(usage, k8s.ResourceLimitsMemory))
+	assert.Equal(t, "0", quantity(usage, k8s.ResourceLimitsCPU))
+}
+
+func TestCheckDeployment(t *testing.T) {
+	c, err := fake.New(testObjects()...)
+	require.Nil(t, err)
+
+	n, err := Load(c, "team")
+	require.Nil(t, err)
+	require.Len(t, n.Pods, 1)
+	usage := n.Usage(nil)
+	assert.Equal(t, "1", quantity(usage, k8s.ResourceRequestsCPU))
+	assert.Equal(t, "1Gi", quantity(usage, k8s.ResourceLimitsMemory))
+
+	d := k8s.NewDeployment("team", "web")
+	d.Spec.Replicas = 4
+	d.Spec.Template.Spec = &k8s.PodSpec{Containers: []k8s.Container{{Name: "web", Image: "nginx"}}}
+	exceeded, err := CheckDeployment(c, d)
+	require.Nil(t, err)
+	assert.Len(t, exceeded, 0)
+
+	d.Spec.Replicas = 5
+	exceeded, err = CheckDeployment(c, d)
+	require.Nil(t, err)
+	require.Len(t, exceeded, 2)
+	assert.Equal(t, "compute", exceeded[0].Quota)
+	assert.Equal(t, k8s.ResourceLimitsMemory, exceeded[0].Resource)
+	assert.Equal(t, "3584Mi", exceeded[0].Used.String())
+	assert.Equal(t, k8s.ResourceRequestsCPU, exceeded[1].Resource)
+	assert.Equal(t, "2250m", exceeded[1].Used.String())
+	assert.Equal(t, "exceeded quota compute: requests.cpu used 2250m, limited to 2", exceeded[1].String())
+
+	// only terminating pods count against the batch quota
+	deadline := int64(600)
+	d.Spec.Replicas = 2
+	d.Spec.Template.Spec.ActiveDeadlineSeconds = &deadline
+	exceeded, err = CheckDeployment(c, d)
+	require.Nil(t, err)
+	require.Len(t, exceeded, 1)
+	assert.Equal(t, "batch", exceeded[0].Quota)
+	assert.Equal(t, int64(2), exceeded[0].Used.Value())
+}
@@ -0,0 +1,277 @@
+package client
+
+const (
+	// RBACGroupName is the API group of the RBAC kinds.
+	RBACGroupName = "rbac.authorization.k8s.io"
+
+	// UserSubjectKind is the kind of subjects that are users.
+	UserSubjectKind = "User"
+	// GroupSubjectKind is the kind of subjects that are groups of users.
+	GroupSubjectKind = "Group"
+	// ServiceAccountSubjectKind is the kind of subjects that are service accounts.
+	ServiceAccountSubjectKind = "ServiceAccount"
+
+	// VerbAll matches every verb in a PolicyRule.
+	VerbAll = "*"
+	// APIGroupAll matches every API group in a PolicyRule.
+	APIGroupAll = "*"
+	// ResourceAll matches every resource in a PolicyRule.
+	ResourceAll = "*"
+	// NonResourceAll matches every non resource URL in a PolicyRule.
+	NonResourceAll = "*"
+)
+
+type (
+	// PolicyRule holds information that describes a policy rule, but does not
+	// contain information about who the rule applies to or which namespace
+	// the rule applies to.
+	PolicyRule struct {
+		// Verbs is a list of Verbs that apply to ALL the ResourceKinds and
+		// AttributeRestrictions contained in this rule. VerbAll represents all kinds.
+		Verbs []string `json:"verbs"`
+		// APIGroups is the name of the APIGroup that contains the resources. If
+		// multiple API groups are specified, any action requested against one
+		// of the enumerated resources in any API group will be allowed.
+		APIGroups []string `json:"apiGroups,omitempty"`
+		// Resources is a list of resources this rule applies to. ResourceAll
+		// represents all resources.
+		Resources []string `json:"resources,omitempty"`
+		// ResourceNames is an optional white list of names that the rule
+		// applies to. An empty set means that everything is allowed.
+		ResourceNames []string `json:"resourceNames,omitempty"`
+		// NonResourceURLs is a set of partial urls that a user should have
+		// access to. *s are allowed, but only as the full, final step in the
+		// path. Rules can either apply to API resources (such as "pods" or
+		// "secrets") or non-resource URL paths (such as "/api"), but not both.
+		NonResourceURLs []string `json:"nonResourceURLs,omitempty"`
+	}
+
+	// Subject contains a reference to the object or user identities a role
+	// binding applies to.
+	Subject struct {
+		// Kind of object being referenced: UserSubjectKind, GroupSubjectKind
+		// or ServiceAccountSubjectKind.
+		Kind string `json:"kind"`
+		// APIGroup holds the API group of the referenced subject. Defaults to
+		// "" for ServiceAccount subjects and to RBACGroupName for User and
+		// Group subjects.
+		APIGroup string `json:"apiGroup,omitempty"`
+		// Name of the object being referenced.
+		Name string `json:"name"`
+		// Namespace of the referenced object. Required for ServiceAccount subjects.
+		Namespace string `json:"namespace,omitempty"`
+	}
+
+	// RoleRef contains information that points to the role being used.
+	RoleRef struct {
+		// APIGroup is the group for the resource being referenced.
+		APIGroup string `json:"apiGroup"`
+		// Kind is the type of resource being referenced, Role or ClusterRole.
+		Kind string `json:"kind"`
+		// Name is the name of resource being referenced.
+		Name string `json:"name"`
+	}
+
+	// Role is a namespaced, logical grouping of PolicyRules that can be
+	// referenced as a unit by a RoleBinding.
+	Role struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Rules holds all the PolicyRules for this Role.
+		Rules []PolicyRule `json:"rules"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// RoleList is a collection of Roles.
+	RoleList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is a list of Roles.
+		Items []Role `json:"items"`
+	}
+
+	// ClusterRole is a cluster level, logical grouping of PolicyRules that
+	// can be referenced as a unit by a RoleBinding or ClusterRoleBinding.
+	ClusterRole struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Rules holds all the PolicyRules for this ClusterRole.
+		Rules []PolicyRule `json:"rules"`
+
+		// AggregationRule is an optional field that describes how to build the
+		// Rules for this ClusterRole. If AggregationRule is set, then the Rules
+		// are controller managed and direct changes to Rules will be stomped by
+		// the controller.
+		AggregationRule *AggregationRule `json:"aggregationRule,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// AggregationRule describes how to locate ClusterRoles to aggregate into the ClusterRole.
+	AggregationRule struct {
+		// ClusterRoleSelectors holds a list of selectors which will be used to
+		// find ClusterRoles and create the rules.
+		ClusterRoleSelectors []LabelSelector `json:"clusterRoleSelectors,omitempty"`
+	}
+
+	// ClusterRoleList is a collection of ClusterRoles.
+	ClusterRoleList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is a list of ClusterRoles.
+		Items []ClusterRole `json:"items"`
+	}
+
+	// RoleBinding references a role, but does not contain it. It can
+	// reference a Role in the same namespace or a ClusterRole in the global
+	// namespace. It adds who information via Subjects and namespace
+	// information by which namespace it exists in.
+	RoleBinding struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Subjects holds references to the objects the role applies to.
+		Subjects []Subject `json:"subjects,omitempty"`
+
+		// RoleRef can reference a Role in the current namespace or a
+		// ClusterRole in the global namespace. It cannot be changed.
+		RoleRef RoleRef `json:"roleRef"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// RoleBindingList is a collection of RoleBindings.
+	RoleBindingList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is a list of RoleBindings.
+		Items []RoleBinding `json:"items"`
+	}
+
+	// ClusterRoleBinding references a ClusterRole, but not contain it. It
+	// adds who information via Subjects.
+	ClusterRoleBinding struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Subjects holds references to the objects the role applies to.
+		Subjects []Subject `json:"subjects,omitempty"`
+
+		// RoleRef can only reference a ClusterRole in the global namespace. It
+		// cannot be changed.
+		RoleRef RoleRef `json:"roleRef"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// ClusterRoleBindingList is a collection of ClusterRoleBindings.
+	ClusterRoleBindingList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is a list of ClusterRoleBindings.
+		Items []ClusterRoleBinding `json:"items"`
+	}
+)
+
+// NewRole creates a new Role struct
+func NewRole(namespace, name string) *Role {
+	return &Role{
+		TypeMeta:   NewTypeMeta("Role", RBACGroupName+"/v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+	}
+}
+
+// NewClusterRole creates a new ClusterRole struct
+func NewClusterRole(name string) *ClusterRole {
+	return &ClusterRole{
+		TypeMeta:   NewTypeMeta("ClusterRole", RBACGroupName+"/v1"),
+		ObjectMeta: NewObjectMeta("", name),
+	}
+}
+
+// NewRoleBinding creates a new RoleBinding struct
+func NewRoleBinding(namespace, name string, roleRef RoleRef, subjects ...Subject) *RoleBinding {
+	return &RoleBinding{
+		TypeMeta:   NewTypeMeta("RoleBinding", RBACGroupName+"/v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+		RoleRef:    roleRef,
+		Subjects:   subjects,
+	}
+}
+
+// NewClusterRoleBinding creates a new ClusterRoleBinding struct
+func NewClusterRoleBinding(name string, roleRef RoleRef, subjects ...Subject) *ClusterRoleBinding {
+	return &ClusterRoleBinding{
+		TypeMeta:   NewTypeMeta("ClusterRoleBinding", RBACGroupName+"/v1"),
+		ObjectMeta: NewObjectMeta("", name),
+		RoleRef:    roleRef,
+		Subjects:   subjects,
+	}
+}
+
+// UnmarshalJSON decodes the Role, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (r *Role) UnmarshalJSON(data []byte) error {
+	type alias Role
+	return unmarshalKeepingUnknown(data, (*alias)(r), &r.raw)
+}
+
+// MarshalJSON encodes the Role, including any fields it was decoded with
+// that this package does not model.
+func (r Role) MarshalJSON() ([]byte, error) {
+	type alias Role
+	return marshalKeepingUnknown(alias(r), r.raw)
+}
+
+// UnmarshalJSON decodes the ClusterRole, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (r *ClusterRole) UnmarshalJSON(data []byte) error {
+	type alias ClusterRole
+	return unmarshalKeepingUnknown(data, (*alias)(r), &r.raw)
+}
+
+// MarshalJSON encodes the ClusterRole, including any fields it was decoded with
+// that this package does not model.
+func (r ClusterRole) MarshalJSON() ([]byte, error) {
+	type alias ClusterRole
+	return marshalKeepingUnknown(alias(r), r.raw)
+}
+
+// UnmarshalJSON decodes the RoleBinding, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (r *RoleBinding) UnmarshalJSON(data []byte) error {
+	type alias RoleBinding
+	return unmarshalKeepingUnknown(data, (*alias)(r), &r.raw)
+}
+
+// MarshalJSON encodes the RoleBinding, including any fields it was decoded with
+// that this package does not model.
+func (r RoleBinding) MarshalJSON() ([]byte, error) {
+	type alias RoleBinding
+	return marshalKeepingUnknown(alias(r), r.raw)
+}
+
+// UnmarshalJSON decodes the ClusterRoleBinding, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (r *ClusterRoleBinding) UnmarshalJSON(data []byte) error {
+	type alias ClusterRoleBinding
+	return unmarshalKeepingUnknown(data, (*alias)(r), &r.raw)
+}
+
+// MarshalJSON encodes the ClusterRoleBinding, including any fields it was decoded with
+// that this package does not model.
+func (r ClusterRoleBinding) MarshalJSON() ([]byte, error) {
+	type alias ClusterRoleBinding
+	return marshalKeepingUnknown(alias(r), r.raw)
+}
@@ -0,0 +1,274 @@
+// Package rbac evaluates RBAC roles and bindings locally, so that access can
+// be audited without asking the API server.
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Client is the subset of the Kubernetes client used to load roles and bindings.
+	Client interface {
+		k8s.RoleInterface
+		k8s.ClusterRoleInterface
+		k8s.RoleBindingInterface
+		k8s.ClusterRoleBindingInterface
+	}
+
+	// User is the identity a request is made as.
+	User struct {
+		// Name is the user name, such as "jane" or
+		// "system:serviceaccount:default:builder".
+		Name string
+		// Groups are the groups the user belongs to.
+		Groups []string
+	}
+
+	// Attributes describe a request. Either Resource or NonResourceURL is set.
+	Attributes struct {
+		// Verb is the verb of the request, such as "get" or "list".
+		Verb string
+		// Namespace of the request. It is empty for cluster scoped resources
+		// and for requests across all namespaces.
+		Namespace string
+		// APIGroup of the resource, which is "" for the core group.
+		APIGroup string
+		// Resource is the plural name of the resource, such as "pods".
+		Resource string
+		// Subresource, such as "log" or "status".
+		Subresource string
+		// Name of the object. It is empty for list, watch and create requests.
+		Name string
+		// NonResourceURL is the path of a request for a non resource URL,
+		// such as "/healthz".
+		NonResourceURL string
+	}
+
+	// Evaluator answers whether users may make requests, using a fixed set of
+	// roles and bindings. Aggregated ClusterRoles are evaluated using the
+	// rules the API server aggregated into them.
+	Evaluator struct {
+		roles               map[string]*k8s.Role
+		clusterRoles        map[string]*k8s.ClusterRole
+		roleBindings        []k8s.RoleBinding
+		clusterRoleBindings []k8s.ClusterRoleBinding
+	}
+)
+
+// ServiceAccountUser returns the identity of a service account, including
+// the groups the API server adds to it.
+func ServiceAccountUser(namespace, name string) User {
+	return User{
+		Name: fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name),
+		Groups: []string{
+			"system:serviceaccounts",
+			"system:serviceaccounts:" + namespace,
+			"system:authenticated",
+		},
+	}
+}
+
+// NewEvaluator returns an Evaluator for the roles and bindings.
+func NewEvaluator(roles []k8s.Role, clusterRoles []k8s.ClusterRole, roleBindings []k8s.RoleBinding, clusterRoleBindings []k8s.ClusterRoleBinding) *Evaluator {
+	e := &Evaluator{
+		roles:               make(map[string]*k8s.Role, len(roles)),
+		clusterRoles:        make(map[string]*k8s.ClusterRole, len(clusterRoles)),
+		roleBindings:        roleBindings,
+		clusterRoleBindings: clusterRoleBindings,
+	}
+	for i := range roles {
+		e.roles[roles[i].Namespace+"/"+roles[i].Name] = &roles[i]
+	}
+	for i := range clusterRoles {
+		e.clusterRoles[clusterRoles[i].Name] = &clusterRoles[i]
+	}
+	return e
+}
+
+// Load returns an Evaluator for all the roles and bindings in the cluster.
+func Load(c Client) (*Evaluator, error) {
+	roles, err := c.ListRoles("", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list roles")
+	}
+	clusterRoles, err := c.ListClusterRoles(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list cluster roles")
+	}
+	roleBindings, err := c.ListRoleBindings("", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list role bindings")
+	}
+	clusterRoleBindings, err := c.ListClusterRoleBindings(nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list cluster role bindings")
+	}
+	return NewEvaluator(roles.Items, clusterRoles.Items, roleBindings.Items, clusterRoleBindings.Items), nil
+}
+
+// Allowed reports whether the user may make the request. When it is
+// allowed, reason names the binding and role that allow it.
+func (e *Evaluator) Allowed(user User, attrs Attributes) (allowed bool, reason string) {
+	for i := range e.clusterRoleBindings {
+		b := &e.clusterRoleBindings[i]
+		if !appliesTo(b.Subjects, user, "") {
+			continue
+		}
+		if rulesAllow(e.rulesFor(b.RoleRef, ""), attrs) {
+			return true, fmt.Sprintf("allowed by ClusterRoleBinding %q of ClusterRole %q", b.Name, b.RoleRef.Name)
+		}
+	}
+
+	// role bindings only grant access within their namespace
+	if attrs.Namespace == "" {
+		return false, ""
+	}
+	for i := range e.roleBindings {
+		b := &e.roleBindings[i]
+		if b.Namespace != attrs.Namespace || !appliesTo(b.Subjects, user, b.Namespace) {
+			continue
+		}
+		if rulesAllow(e.rulesFor(b.RoleRef, b.Namespace), attrs) {
+			return true, fmt.Sprintf("allowed by RoleBinding %q of %s %q in namespace %q", b.Name, b.RoleRef.Kind, b.RoleRef.Name, b.Namespace)
+		}
+	}
+	return false, ""
+}
+
+// Rules returns the rules that apply to the user in the namespace,
+// including those of ClusterRoleBindings. An empty namespace returns only
+// the rules that apply cluster wide.
+func (e *Evaluator) Rules(user User, namespace string) []k8s.PolicyRule {
+	var rules []k8s.PolicyRule
+	for i := range e.clusterRoleBindings {
+		b := &e.clusterRoleBindings[i]
+		if appliesTo(b.Subjects, user, "") {
+			rules = append(rules, e.rulesFor(b.RoleRef, "")...)
+		}
+	}
+	if namespace == "" {
+		return rules
+	}
+	for i := range e.roleBindings {
+		b := &e.roleBindings[i]
+		if b.Namespace == namespace && appliesTo(b.Subjects, user, b.Namespace) {
+			rules = append(rules, e.rulesFor(b.RoleRef, b.Namespace)...)
+		}
+	}
+	return rules
+}
+
+// rulesFor returns the rules of the referenced role. Bindings to roles
+// that do not exist grant nothing.
+func (e *Evaluator) rulesFor(ref k8s.RoleRef, namespace string) []k8s.PolicyRule {
+	switch ref.Kind {
+	case "ClusterRole":
+		if r, ok := e.clusterRoles[ref.Name]; ok {
+			return r.Rules
+		}
+	case "Role":
+		if r, ok := e.roles[namespace+"/"+ref.Name]; ok {
+			return r.Rules
+		}
+	}
+	return nil
+}
+
+// appliesTo reports whether any of the subjects is the user or one of its
+// groups. ServiceAccount subjects without a namespace default to the
+// namespace of the binding.
+func appliesTo(subjects []k8s.Subject, user User, namespace string) bool {
+	for _, s := range subjects {
+		switch s.Kind {
+		case k8s.UserSubjectKind:
+			if s.Name == user.Name {
+				return true
+			}
+		case k8s.GroupSubjectKind:
+			for _, g := range user.Groups {
+				if s.Name == g {
+					return true
+				}
+			}
+		case k8s.ServiceAccountSubjectKind:
+			ns := s.Namespace
+			if ns == "" {
+				ns = namespace
+			}
+			if ns != "" && ServiceAccountUser(ns, s.Name).Name == user.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func rulesAllow(rules []k8s.PolicyRule, attrs Attributes) bool {
+	for i := range rules {
+		if ruleAllows(&rules[i], attrs) {
+			return true
+		}
+	}
+	return false
+}
+
+// ruleAllows reports whether the rule allows the request.
+func ruleAllows(rule *k8s.PolicyRule, attrs Attributes) bool {
+	if !hasOrAll(rule.Verbs, attrs.Verb, k8s.VerbAll) {
+		return false
+	}
+
+	if attrs.NonResourceURL != "" {
+		for _, u := range rule.NonResourceURLs {
+			if u == k8s.NonResourceAll || u == attrs.NonResourceURL ||
+				(strings.HasSuffix(u, "*") && strings.HasPrefix(attrs.NonResourceURL, strings.TrimSuffix(u, "*"))) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !hasOrAll(rule.APIGroups, attrs.APIGroup, k8s.APIGroupAll) {
+		return false
+	}
+	if !resourceMatches(rule.Resources, attrs.Resource, attrs.Subresource) {
+		return false
+	}
+	return len(rule.ResourceNames) == 0 || has(rule.ResourceNames, attrs.Name)
+}
+
+// resourceMatches handles "*", "pods", "pods/log", "pods/*" and "*/scale".
+func resourceMatches(resources []string, resource, subresource string) bool {
+	combined := resource
+	if subresource != "" {
+		combined = resource + "/" + subresource
+	}
+	for _, r := range resources {
+		switch {
+		case r == k8s.ResourceAll, r == combined:
+			return true
+		case subresource != "" && r == resource+"/*":
+			return true
+		case subresource != "" && r == "*/"+subresource:
+			return true
+		}
+	}
+	return false
+}
+
+func hasOrAll(values []string, value, all string) bool {
+	return has(values, all) || has(values, value)
+}
+
+func has(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package rbac
+
+import (
+	"testing"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testObjects() []k8s.Object {
+	viewer := k8s.NewClusterRole("viewer")
+	viewer.Rules = []k8s.PolicyRule{
+		{Verbs: []string{"get", "list", "watch"}, APIGroups: []string{"", "apps"}, Resources: []string{"pods", "deployments", "pods/log"}},
+		{Verbs: []string{"get"}, NonResourceURLs: []string{"/healthz", "/metrics/*"}},
+	}
+	admin := k8s.NewClusterRole("admin")
+	admin.Rules = []k8s.PolicyRule{{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}}}
+
+	deployer := k8s.NewRole("web", "deployer")
+	deployer.Rules = []k8s.PolicyRule{
+		{Verbs: []string{"update", "patch"}, APIGroups: []string{"apps"}, Resources: []string{"deployments", "*/scale"}},
+		{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"registry"}},
+	}
+
+	return []k8s.Object{
+		viewer, admin, deployer,
+		k8s.NewClusterRoleBinding("viewers",
+			k8s.RoleRef{APIGroup: k8s.RBACGroupName, Kind: "ClusterRole", Name: "viewer"},
+			k8s.Subject{Kind: k8s.GroupSubjectKind, APIGroup: k8s.RBACGroupName, Name: "developers"}),
+		k8s.NewRoleBinding("web", "deployers",
+			k8s.RoleRef{APIGroup: k8s.RBACGroupName, Kind: "Role", Name: "deployer"},
+			k8s.Subject{Kind: k8s.ServiceAccountSubjectKind, Name: "ci"}),
+		k8s.NewRoleBinding("web", "admins",
+			k8s.RoleRef{APIGroup: k8s.RBACGroupName, Kind: "ClusterRole", Name: "admin"},
+			k8s.Subject{Kind: k8s.UserSubjectKind, APIGroup: k8s.RBACGroupName, Name: "jane"}),
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	c, err := fake.New(testObjects()...)
+	require.Nil(t, err)
+	e, err := Load(c)
+	require.Nil(t, err)
+
+	developer := User{Name: "bob", Groups: []string{"developers"}}
+	jane := User{Name: "jane"}
+	ci := ServiceAccountUser("web", "ci")
+
+	for _, tc := range []struct {
+		user    User
+		attrs   Attributes
+		allowed bool
+	}{
+		{developer, Attributes{Verb: "list", Resource: "pods"}, true},
+		{developer, Attributes{Verb: "get", Namespace: "web", Resource: "pods", Subresource: "log", Name: "web-1"}, true},
+		{developer, Attributes{Verb: "get", Namespace: "web", Resource: "pods", Subresource: "exec", Name: "web-1"}, false},
+		{developer, Attributes{Verb: "get", APIGroup: "apps", Namespace: "web", Resource: "deployments"}, true},
+		{developer, Attributes{Verb: "delete", Namespace: "web", Resource: "pods"}, false},
+		{developer, Attributes{Verb: "get", NonResourceURL: "/metrics/cadvisor"}, true},
+		{developer, Attributes{Verb: "get", NonResourceURL: "/version"}, false},
+
+		{jane, Attributes{Verb: "delete", Namespace: "web", Resource: "secrets", Name: "tls"}, true},
+		{jane, Attributes{Verb: "delete", Namespace: "other", Resource: "secrets", Name: "tls"}, false},
+		{jane, Attributes{Verb: "list", Resource: "nodes"}, false},
+
+		{ci, Attributes{Verb: "patch", APIGroup: "apps", Namespace: "web", Resource: "deployments", Name: "web"}, true},
+		{ci, Attributes{Verb: "update", APIGroup: "apps", Namespace: "web", Resource: "statefulsets", Subresource: "scale", Name: "db"}, true},
+		{ci, Attributes{Verb: "patch", APIGroup: "apps", Namespace: "other", Resource: "deployments", Name: "web"}, false},
+		{ci, Attributes{Verb: "get", Namespace: "web", Resource: "secrets", Name: "registry"}, true},
+		{ci, Attributes{Verb: "get", Namespace: "web", Resource: "secrets", Name: "tls"}, false},
+		{ServiceAccountUser("other", "ci"), Attributes{Verb: "patch", APIGroup: "apps", Namespace: "web", Resource: "deployments", Name: "web"}, false},
+	} {
+		allowed, reason := e.Allowed(tc.user, tc.attrs)
+		assert.Equal(t, tc.allowed, allowed, "%s %+v", tc.user.Name, tc.attrs)
+		assert.Equal(t, allowed, reason != "", reason)
+	}
+}
+
+func TestRules(t *testing.T) {
+	var roles []k8s.Role
+	var bindings []k8s.RoleBinding
+	for _, obj := range testObjects() {
+		switch o := obj.(type) {
+		case *k8s.Role:
+			roles = append(roles, *o)
+		case *k8s.RoleBinding:
+			bindings = append(bindings, *o)
+		}
+	}
+	e := NewEvaluator(roles, nil, bindings, nil)
+
+	assert.Len(t, e.Rules(ServiceAccountUser("web", "ci"), "web"), 2)
+	assert.Empty(t, e.Rules(ServiceAccountUser("web", "ci"), ""))
+	assert.Empty(t, e.Rules(User{Name: "jane"}, "web"), "the admin ClusterRole is missing")
+}
@@ -0,0 +1,319 @@
+// Package record provides an EventRecorder that controllers can use to emit
+// Kubernetes Events about the objects they manage.
+package record
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultBurst is the number of events about a single object that may be
+	// sent at once before rate limiting starts.
+	DefaultBurst = 25
+	// DefaultInterval is how often another event about a single object may
+	// be sent once the burst is used up.
+	DefaultInterval = 5 * time.Minute
+	// DefaultQueueSize is the number of events that may wait to be sent.
+	DefaultQueueSize = 1000
+
+	// maxCacheSize bounds the number of events kept for aggregation.
+	maxCacheSize = 4096
+)
+
+type (
+	// EventRecorder records events about objects.
+	EventRecorder interface {
+		// Event records an event about the object. eventType is
+		// k8s.EventTypeNormal or k8s.EventTypeWarning. reason is a short
+		// UpperCamelCase reason for the event, such as "ScalingReplicaSet".
+		Event(obj k8s.Object, eventType, reason, message string)
+		// Eventf is like Event, but formats the message using fmt.Sprintf.
+		Eventf(obj k8s.Object, eventType, reason, format string, args ...interface{})
+	}
+
+	// Recorder is an EventRecorder that sends events asynchronously. An event
+	// identical to one sent before, apart from its time, is sent by
+	// incrementing the count of the existing event rather than creating
+	// another. Events about a single object are rate limited, and events
+	// that are rate limited, or that arrive while the queue is full, are
+	// dropped.
+	Recorder struct {
+		client       k8s.EventInterface
+		source       k8s.EventSource
+		burst        int
+		interval     time.Duration
+		queueSize    int
+		errorHandler func(error)
+		now          func() time.Time
+
+		queue chan *k8s.Event
+		done  chan struct{}
+
+		mu     sync.Mutex
+		closed bool
+
+		// only used by run
+		limits  map[string]*bucket
+		entries map[string]*k8s.Event
+	}
+
+	// OptionsFunc is a function passed to NewRecorder for setting options.
+	OptionsFunc func(*Recorder) error
+
+	// bucket is a token bucket for the events about a single object.
+	bucket struct {
+		tokens float64
+		last   time.Time
+	}
+)
+
+var _ EventRecorder = &Recorder{}
+
+// NewRecorder creates a Recorder that reports events as coming from the
+// named component. Close must be called to flush queued events.
+func NewRecorder(c k8s.EventInterface, component string, options ...OptionsFunc) (*Recorder, error) {
+	r := &Recorder{
+		client:       c,
+		source:       k8s.EventSource{Component: component},
+		burst:        DefaultBurst,
+		interval:     DefaultInterval,
+		queueSize:    DefaultQueueSize,
+		errorHandler: func(error) {},
+		now:          time.Now,
+		limits:       make(map[string]*bucket),
+		entries:      make(map[string]*k8s.Event),
+	}
+	for _, f := range options {
+		if err := f(r); err != nil {
+			return nil, errors.Wrap(err, "options function failed")
+		}
+	}
+
+	r.queue = make(chan *k8s.Event, r.queueSize)
+	r.done = make(chan struct{})
+	go r.run()
+	return r, nil
+}
+
+// SetHost sets the node name reported as the source of events.
+func SetHost(host string) OptionsFunc {
+	return func(r *Recorder) error {
+		r.source.Host = host
+		return nil
+	}
+}
+
+// SetRateLimit sets how many events about a single object may be sent at
+// once, and how often another may be sent after that.
+func SetRateLimit(burst int, interval time.Duration) OptionsFunc {
+	return func(r *Recorder) error {
+		if burst < 1 || interval <= 0 {
+			return errors.New("burst and interval must be positive")
+		}
+		r.burst = burst
+		r.interval = interval
+		return nil
+	}
+}
+
+// SetQueueSize sets how many events may wait to be sent.
+func SetQueueSize(size int) OptionsFunc {
+	return func(r *Recorder) error {
+		if size < 1 {
+			return errors.New("queue size must be positive")
+		}
+		r.queueSize = size
+		return nil
+	}
+}
+
+// SetErrorHandler sets a function that is called with errors sending
+// events and with events that are dropped. By default they are ignored.
+func SetErrorHandler(f func(error)) OptionsFunc {
+	return func(r *Recorder) error {
+		r.errorHandler = f
+		return nil
+	}
+}
+
+// Event records an event about the object. The object's kind and name must
+// be set. Events about cluster scoped objects are created in the default
+// namespace.
+func (r *Recorder) Event(obj k8s.Object, eventType, reason, message string) {
+	ref, err := k8s.GetReference(obj)
+	if err != nil {
+		r.errorHandler(errors.Wrapf(err, "failed to record event %s", reason))
+		return
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	now := k8s.Time{Time: r.now()}
+	event := k8s.NewEvent(namespace, "")
+	event.GenerateName = ref.Name + "."
+	event.InvolvedObject = *ref
+	event.Type = eventType
+	event.Reason = reason
+	event.Message = message
+	event.Source = r.source
+	event.FirstTimestamp = now
+	event.LastTimestamp = now
+	event.Count = 1
+
+	// the handler is called without the lock held, so that it may record
+	// events itself
+	if err := r.enqueue(event); err != nil {
+		r.errorHandler(errors.Wrapf(err, "dropped event %s about %s %q", reason, ref.Kind, ref.Name))
+	}
+}
+
+// enqueue adds the event to the queue unless the recorder is closed or the
+// queue is full.
+func (r *Recorder) enqueue(event *k8s.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return errors.New("recorder is closed")
+	}
+	select {
+	case r.queue <- event:
+		return nil
+	default:
+		return errors.New("queue is full")
+	}
+}
+
+// Eventf is like Event, but formats the message using fmt.Sprintf.
+func (r *Recorder) Eventf(obj k8s.Object, eventType, reason, format string, args ...interface{}) {
+	r.Event(obj, eventType, reason, fmt.Sprintf(format, args...))
+}
+
+// Close sends any queued events and stops the recorder. Events recorded
+// after Close are dropped.
+func (r *Recorder) Close() {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mu.Unlock()
+	<-r.done
+}
+
+func (r *Recorder) run() {
+	defer close(r.done)
+	for event := range r.queue {
+		if !r.allow(objectKey(&event.InvolvedObject), event.LastTimestamp.Time) {
+			r.errorHandler(errors.Errorf("rate limited, dropped event %s about %s %q",
+				event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name))
+			continue
+		}
+		if err := r.send(event); err != nil {
+			r.errorHandler(err)
+		}
+	}
+}
+
+// allow takes a token from the bucket of the object, if there is one.
+func (r *Recorder) allow(key string, now time.Time) bool {
+	b, ok := r.limits[key]
+	if !ok {
+		if len(r.limits) >= maxCacheSize {
+			r.limits = make(map[string]*bucket)
+		}
+		b = &bucket{tokens: float64(r.burst), last: now}
+		r.limits[key] = b
+	}
+
+	b.tokens += float64(now.Sub(b.last)) / float64(r.interval)
+	if b.tokens > float64(r.burst) {
+		b.tokens = float64(r.burst)
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// send creates the event, or increments the count of an identical event
+// that was sent before.
+func (r *Recorder) send(event *k8s.Event) error {
+	key := eventKey(event)
+	if previous, ok := r.entries[key]; ok {
+		out, err := r.increment(previous, event.LastTimestamp)
+		if err == nil {
+			r.entries[key] = out
+			return nil
+		}
+		delete(r.entries, key)
+		// events expire, so an event seen before may be gone
+		if !k8s.IsNotFoundError(err) {
+			return errors.Wrapf(err, "failed to update event %s", event.Reason)
+		}
+	}
+
+	out, err := r.client.CreateEvent(event.Namespace, event)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create event %s", event.Reason)
+	}
+	if len(r.entries) >= maxCacheSize {
+		r.entries = make(map[string]*k8s.Event)
+	}
+	r.entries[key] = out
+	return nil
+}
+
+// increment updates the count and last time of an event that was sent
+// before. If the event was changed since, such as by another recorder for
+// the same component, the latest version is fetched and the update retried.
+func (r *Recorder) increment(previous *k8s.Event, last k8s.Time) (*k8s.Event, error) {
+	current := previous
+	var out *k8s.Event
+	err := k8s.RetryOnConflict(k8s.DefaultRetry, func() error {
+		if current == nil {
+			latest, err := r.client.GetEvent(previous.Namespace, previous.Name)
+			if err != nil {
+				return err
+			}
+			current = latest
+		}
+		updated := *current
+		updated.Count++
+		updated.LastTimestamp = last
+
+		var err error
+		out, err = r.client.UpdateEvent(updated.Namespace, &updated)
+		if k8s.IsConflictError(err) {
+			current = nil
+		}
+		return err
+	})
+	return out, err
+}
+
+func objectKey(ref *k8s.ObjectReference) string {
+	return strings.Join([]string{ref.Kind, ref.APIVersion, ref.Namespace, ref.Name, string(ref.UID)}, "/")
+}
+
+// eventKey identifies events that are aggregated into one.
+func eventKey(event *k8s.Event) string {
+	return strings.Join([]string{
+		objectKey(&event.InvolvedObject),
+		event.InvolvedObject.FieldPath,
+		event.Source.Component,
+		event.Source.Host,
+		event.Type,
+		event.Reason,
+		event.Message,
+	}, "/")
+}
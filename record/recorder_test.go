@@ -0,0 +1,145 @@
+package record
+
+import (
+	"testing"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPod(t *testing.T, c *fake.Client, name string) *k8s.Pod {
+	pod, err := c.CreatePod("default", &k8s.Pod{ObjectMeta: k8s.NewObjectMeta("", name)})
+	require.Nil(t, err)
+	return pod
+}
+
+func TestRecorderAggregates(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	web := newPod(t, c, "web")
+	db := newPod(t, c, "db")
+
+	r, err := NewRecorder(c, "test-controller", SetHost("node-1"))
+	require.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		r.Event(web, k8s.EventTypeWarning, "BackOff", "restarting failed container")
+	}
+	r.Eventf(web, k8s.EventTypeNormal, "Pulled", "pulled image %q", "nginx")
+	r.Event(db, k8s.EventTypeNormal, "Pulled", "pulled image \"nginx\"")
+	r.Close()
+
+	list, err := k8s.ListEventsFor(c, web)
+	require.Nil(t, err)
+	require.Len(t, list.Items, 2)
+
+	counts := map[string]int32{}
+	for _, event := range list.Items {
+		counts[event.Reason] = event.Count
+		assert.Equal(t, "web", event.InvolvedObject.Name)
+		assert.Equal(t, web.UID, event.InvolvedObject.UID)
+		assert.Equal(t, k8s.EventSource{Component: "test-controller", Host: "node-1"}, event.Source)
+	}
+	assert.Equal(t, map[string]int32{"BackOff": 3, "Pulled": 1}, counts)
+
+	list, err = k8s.ListEventsFor(c, db)
+	require.Nil(t, err)
+	assert.Len(t, list.Items, 1)
+}
+
+func TestRecorderRateLimits(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	web := newPod(t, c, "web")
+
+	var dropped []error
+	r, err := NewRecorder(c, "test-controller",
+		SetRateLimit(2, time.Minute),
+		SetErrorHandler(func(err error) { dropped = append(dropped, err) }),
+	)
+	require.Nil(t, err)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	r.Event(web, k8s.EventTypeNormal, "First", "first")
+	r.Event(web, k8s.EventTypeNormal, "Second", "second")
+	r.Event(web, k8s.EventTypeNormal, "Third", "third")
+	r.Close()
+
+	list, err := k8s.ListEventsFor(c, web)
+	require.Nil(t, err)
+	assert.Len(t, list.Items, 2)
+	assert.Len(t, dropped, 1)
+
+	r.Event(web, k8s.EventTypeNormal, "Closed", "closed")
+	assert.Len(t, dropped, 2, "events after close are dropped")
+}
+
+func TestRecorderRequiresKind(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+
+	var errs []error
+	r, err := NewRecorder(c, "test-controller", SetErrorHandler(func(err error) { errs = append(errs, err) }))
+	require.Nil(t, err)
+	r.Event(&k8s.Pod{ObjectMeta: k8s.NewObjectMeta("default", "web")}, k8s.EventTypeNormal, "Started", "started")
+	r.Close()
+	assert.Len(t, errs, 1)
+}
+
+func TestRecorderRetriesConflicts(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	web := newPod(t, c, "web")
+
+	var errs []error
+	r, err := NewRecorder(c, "test-controller", SetErrorHandler(func(err error) { errs = append(errs, err) }))
+	require.Nil(t, err)
+
+	r.Event(web, k8s.EventTypeWarning, "BackOff", "restarting failed container")
+	var list *k8s.EventList
+	require.Eventually(t, func() bool {
+		list, err = k8s.ListEventsFor(c, web)
+		return err == nil && len(list.Items) == 1
+	}, time.Second, time.Millisecond)
+
+	// another recorder counts the same event, so the recorder's copy is stale
+	event := list.Items[0]
+	event.Count = 5
+	_, err = c.UpdateEvent(event.Namespace, &event)
+	require.Nil(t, err)
+
+	r.Event(web, k8s.EventTypeWarning, "BackOff", "restarting failed container")
+	r.Close()
+	assert.Empty(t, errs)
+
+	list, err = k8s.ListEventsFor(c, web)
+	require.Nil(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, int32(6), list.Items[0].Count)
+}
+
+func TestRecorderErrorHandlerMayRecord(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	web := newPod(t, c, "web")
+
+	var (
+		r     *Recorder
+		calls int
+	)
+	r, err = NewRecorder(c, "test-controller", SetErrorHandler(func(err error) {
+		calls++
+		if calls == 1 {
+			// this would deadlock if the handler was called with the lock held
+			r.Event(web, k8s.EventTypeWarning, "Dropped", err.Error())
+		}
+	}))
+	require.Nil(t, err)
+	r.Close()
+
+	r.Event(web, k8s.EventTypeNormal, "Closed", "closed")
+	assert.Equal(t, 2, calls)
+}
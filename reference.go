@@ -0,0 +1,31 @@
+package client
+
+import "github.com/pkg/errors"
+
+// GetReference returns an ObjectReference to the object. The object's kind
+// and name must be set, which is not the case for the items of a list.
+func GetReference(obj Object) (*ObjectReference, error) {
+	if obj == nil {
+		return nil, errors.New("cannot reference a nil object")
+	}
+	if obj.GetKind() == "" {
+		return nil, errors.Errorf("object %q must have kind set", obj.GetName())
+	}
+	if obj.GetName() == "" {
+		return nil, errors.Errorf("%s must have name set", obj.GetKind())
+	}
+
+	ref := &ObjectReference{
+		Kind:       obj.GetKind(),
+		APIVersion: obj.GetAPIVersion(),
+		Name:       obj.GetName(),
+		UID:        obj.GetUID(),
+	}
+	if o, ok := obj.(interface{ GetNamespace() string }); ok {
+		ref.Namespace = o.GetNamespace()
+	}
+	if o, ok := obj.(interface{ GetResourceVersion() string }); ok {
+		ref.ResourceVersion = o.GetResourceVersion()
+	}
+	return ref, nil
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReference(t *testing.T) {
+	rs := NewReplicaSet("default", "web-1234")
+	rs.UID = "rs-uid"
+	rs.ResourceVersion = "42"
+
+	ref, err := GetReference(rs)
+	require.Nil(t, err)
+	assert.Equal(t, ObjectReference{
+		Kind:            "ReplicaSet",
+		APIVersion:      "extensions/v1beta1",
+		Namespace:       "default",
+		Name:            "web-1234",
+		UID:             "rs-uid",
+		ResourceVersion: "42",
+	}, *ref)
+
+	ref, err = GetReference(NewUnstructured("v1", "Node", "", "node-1"))
+	require.Nil(t, err)
+	assert.Equal(t, "", ref.Namespace)
+
+	// items of a list have no kind
+	_, err = GetReference(&ReplicaSet{ObjectMeta: rs.ObjectMeta})
+	assert.NotNil(t, err)
+}
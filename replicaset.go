@@ -1,21 +1,6 @@
 package client
 
 type (
-	// ReplicaSetInterface has methods to work with ReplicaSet resources.
-	ReplicaSetInterface interface {
-		CreateReplicaSet(namespace string, item *ReplicaSet) (*ReplicaSet, error)
-		GetReplicaSet(namespace, name string) (result *ReplicaSet, err error)
-		ListReplicaSets(namespace string, opts *ListOptions) (*ReplicaSetList, error)
-		WatchReplicaSets(namespace string, opts *WatchOptions, events chan ReplicaSetWatchEvent) error
-		DeleteReplicaSet(namespace, name string) error
-		UpdateReplicaSet(namespace string, item *ReplicaSet) (*ReplicaSet, error)
-	}
-
-	ReplicaSetWatchEvent interface {
-		Type() WatchEventType
-		Object() (*ReplicaSet, error)
-	}
-
 	// ReplicaSetList is a collection of ReplicaSets.
 	ReplicaSetList struct {
 		TypeMeta `json:",inline"`
@@ -34,6 +19,9 @@ type (
 		// Status is the current status of this ReplicaSet. This data may be
 		// out of date by some window of time.
 		Status *ReplicaSetStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// ReplicaSetStatus represents the current status of a ReplicaSet.
@@ -75,3 +63,17 @@ func NewReplicaSet(namespace, name string) *ReplicaSet {
 		},
 	}
 }
+
+// UnmarshalJSON decodes the ReplicaSet, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (r *ReplicaSet) UnmarshalJSON(data []byte) error {
+	type alias ReplicaSet
+	return unmarshalKeepingUnknown(data, (*alias)(r), &r.raw)
+}
+
+// MarshalJSON encodes the ReplicaSet, including any fields it was decoded with
+// that this package does not model.
+func (r ReplicaSet) MarshalJSON() ([]byte, error) {
+	type alias ReplicaSet
+	return marshalKeepingUnknown(alias(r), r.raw)
+}
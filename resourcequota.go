@@ -0,0 +1,128 @@
+package client
+
+const (
+	// ResourcePods is the number of pods.
+	ResourcePods ResourceName = "pods"
+	// ResourceRequestsCPU is the total CPU requested by pods.
+	ResourceRequestsCPU ResourceName = "requests.cpu"
+	// ResourceRequestsMemory is the total memory requested by pods.
+	ResourceRequestsMemory ResourceName = "requests.memory"
+	// ResourceLimitsCPU is the total CPU limit of pods.
+	ResourceLimitsCPU ResourceName = "limits.cpu"
+	// ResourceLimitsMemory is the total memory limit of pods.
+	ResourceLimitsMemory ResourceName = "limits.memory"
+
+	// ResourceQuotaScopeTerminating matches pods with an active deadline.
+	ResourceQuotaScopeTerminating ResourceQuotaScope = "Terminating"
+	// ResourceQuotaScopeNotTerminating matches pods without an active deadline.
+	ResourceQuotaScopeNotTerminating ResourceQuotaScope = "NotTerminating"
+	// ResourceQuotaScopeBestEffort matches pods that request and limit no
+	// CPU or memory.
+	ResourceQuotaScopeBestEffort ResourceQuotaScope = "BestEffort"
+	// ResourceQuotaScopeNotBestEffort matches pods that request or limit
+	// CPU or memory.
+	ResourceQuotaScopeNotBestEffort ResourceQuotaScope = "NotBestEffort"
+	// ResourceQuotaScopePriorityClass matches pods by their priority class,
+	// using a ScopeSelector.
+	ResourceQuotaScopePriorityClass ResourceQuotaScope = "PriorityClass"
+
+	ScopeSelectorOpIn           ScopeSelectorOperator = "In"
+	ScopeSelectorOpNotIn        ScopeSelectorOperator = "NotIn"
+	ScopeSelectorOpExists       ScopeSelectorOperator = "Exists"
+	ScopeSelectorOpDoesNotExist ScopeSelectorOperator = "DoesNotExist"
+)
+
+type (
+	// ResourceQuota sets aggregate quota restrictions enforced per namespace.
+	ResourceQuota struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec defines the desired quota.
+		Spec *ResourceQuotaSpec `json:"spec,omitempty"`
+		// Status defines the actual enforced quota and its current usage.
+		Status *ResourceQuotaStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// ResourceQuotaList is a list of ResourceQuota items.
+	ResourceQuotaList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		Items []ResourceQuota `json:"items"`
+	}
+
+	// ResourceQuotaSpec defines the desired hard limits to enforce for Quota.
+	ResourceQuotaSpec struct {
+		// Hard is the set of desired hard limits for each named resource.
+		Hard ResourceList `json:"hard,omitempty"`
+		// A collection of filters that must match each object tracked by a
+		// quota. If not specified, the quota matches all objects.
+		Scopes []ResourceQuotaScope `json:"scopes,omitempty"`
+		// ScopeSelector is also a collection of filters like Scopes that must
+		// match each object tracked by a quota, but expressed using
+		// ScopeSelectorOperator in combination with possible values.
+		ScopeSelector *ScopeSelector `json:"scopeSelector,omitempty"`
+	}
+
+	// ScopeSelector represents the AND of the selectors represented by the
+	// scoped-resource selector requirements.
+	ScopeSelector struct {
+		// A list of scope selector requirements by scope of the resources.
+		MatchExpressions []ScopedResourceSelectorRequirement `json:"matchExpressions,omitempty"`
+	}
+
+	// ScopedResourceSelectorRequirement is a selector that contains values,
+	// a scope name, and an operator that relates the scope name and values.
+	ScopedResourceSelectorRequirement struct {
+		// The name of the scope that the selector applies to.
+		ScopeName ResourceQuotaScope `json:"scopeName"`
+		// Represents a scope's relationship to a set of values.
+		Operator ScopeSelectorOperator `json:"operator"`
+		// An array of string values. If the operator is In or NotIn, the
+		// values array must be non-empty. If the operator is Exists or
+		// DoesNotExist, the values array must be empty.
+		Values []string `json:"values,omitempty"`
+	}
+
+	// ResourceQuotaStatus defines the enforced hard limits and observed use.
+	ResourceQuotaStatus struct {
+		// Hard is the set of enforced hard limits for each named resource.
+		Hard ResourceList `json:"hard,omitempty"`
+		// Used is the current observed total usage of the resource in the namespace.
+		Used ResourceList `json:"used,omitempty"`
+	}
+
+	// ResourceQuotaScope defines a filter that must match each object tracked by a quota.
+	ResourceQuotaScope string
+
+	// ScopeSelectorOperator is the set of operators that can be used in a
+	// scope selector requirement.
+	ScopeSelectorOperator string
+)
+
+// NewResourceQuota creates a new ResourceQuota struct
+func NewResourceQuota(namespace, name string) *ResourceQuota {
+	return &ResourceQuota{
+		TypeMeta:   NewTypeMeta("ResourceQuota", "v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+		Spec:       &ResourceQuotaSpec{Hard: ResourceList{}},
+	}
+}
+
+// UnmarshalJSON decodes the ResourceQuota, keeping the fields this package
+// does not model so that they are sent back by MarshalJSON.
+func (r *ResourceQuota) UnmarshalJSON(data []byte) error {
+	type alias ResourceQuota
+	return unmarshalKeepingUnknown(data, (*alias)(r), &r.raw)
+}
+
+// MarshalJSON encodes the ResourceQuota, including any fields it was decoded
+// with that this package does not model.
+func (r ResourceQuota) MarshalJSON() ([]byte, error) {
+	type alias ResourceQuota
+	return marshalKeepingUnknown(alias(r), r.raw)
+}
@@ -0,0 +1,265 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// OperationResultNone means the object already existed and was not changed.
+	OperationResultNone OperationResult = "unchanged"
+	// OperationResultCreated means the object did not exist and was created.
+	OperationResultCreated OperationResult = "created"
+	// OperationResultUpdated means the object existed and was updated.
+	OperationResultUpdated OperationResult = "updated"
+)
+
+// DefaultRetry is the recommended backoff for conflicts where a client may
+// be attempting to make an unrelated modification to a resource under
+// active management by one or more controllers.
+var DefaultRetry = Backoff{
+	Steps:    5,
+	Duration: 10 * time.Millisecond,
+	Factor:   1.0,
+	Jitter:   0.1,
+}
+
+type (
+	// Backoff describes how long to wait between attempts.
+	Backoff struct {
+		// Duration is the time to wait after the first attempt.
+		Duration time.Duration
+		// Factor multiplies Duration after each attempt. Values below 1 are treated as 1.
+		Factor float64
+		// Jitter adds a random amount of up to Jitter*Duration to each wait.
+		Jitter float64
+		// Steps is the maximum number of attempts.
+		Steps int
+	}
+
+	// OperationResult is the action taken by CreateOrUpdate and CreateOrPatch.
+	OperationResult string
+
+	// ObjectKey identifies an object by namespace and name.
+	ObjectKey struct {
+		Namespace string
+		Name      string
+	}
+
+	// ObjectFuncs are the typed operations used by CreateOrUpdate and
+	// CreateOrPatch, such as NewDeployment and a client's GetDeployment,
+	// CreateDeployment and UpdateDeployment. Patch is only needed by
+	// CreateOrPatch, and Update only by CreateOrUpdate.
+	ObjectFuncs[T Object] struct {
+		New    func(namespace, name string) T
+		Get    func(namespace, name string) (T, error)
+		Create func(namespace string, item T) (T, error)
+		Update func(namespace string, item T) (T, error)
+		Patch  func(namespace, name string, pt PatchType, data []byte) (T, error)
+	}
+)
+
+// RetryOnConflict calls fn until it returns an error that is not a conflict
+// error or the backoff is exhausted. fn should fetch the latest version of
+// the object, apply its changes and update it, so that each attempt works
+// with a fresh resource version. The last error from fn is returned.
+func RetryOnConflict(backoff Backoff, fn func() error) error {
+	if backoff.Steps < 1 {
+		backoff.Steps = 1
+	}
+	if backoff.Factor < 1 {
+		backoff.Factor = 1
+	}
+
+	duration := backoff.Duration
+	var err error
+	for i := 0; i < backoff.Steps; i++ {
+		if i > 0 {
+			wait := duration
+			if backoff.Jitter > 0 {
+				wait += time.Duration(rand.Float64() * backoff.Jitter * float64(duration))
+			}
+			time.Sleep(wait)
+			duration = time.Duration(float64(duration) * backoff.Factor)
+		}
+
+		err = fn()
+		if !IsConflictError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// CreateOrUpdate fetches the object identified by key. If it does not exist,
+// a new object is built with ops.New, passed to mutate and created.
+// Otherwise, mutate is applied to the existing object, which is updated only
+// if mutate changed it. Updates that fail with a conflict are retried with
+// DefaultRetry, calling mutate again on a freshly fetched object. mutate must
+// not change the name or namespace of the object.
+func CreateOrUpdate[T Object](ops ObjectFuncs[T], key ObjectKey, mutate func(T) error) (T, OperationResult, error) {
+	var (
+		out    T
+		result OperationResult
+	)
+	err := RetryOnConflict(DefaultRetry, func() error {
+		obj, err := ops.Get(key.Namespace, key.Name)
+		if IsNotFoundError(err) {
+			obj = ops.New(key.Namespace, key.Name)
+			if err := mutateKeepingKey(obj, key, mutate); err != nil {
+				return err
+			}
+			out, err = ops.Create(key.Namespace, obj)
+			result = OperationResultCreated
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		before, err := json.Marshal(obj)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal object")
+		}
+		if err := mutateKeepingKey(obj, key, mutate); err != nil {
+			return err
+		}
+		after, err := json.Marshal(obj)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal object")
+		}
+		if bytes.Equal(before, after) {
+			out, result = obj, OperationResultNone
+			return nil
+		}
+
+		out, err = ops.Update(key.Namespace, obj)
+		result = OperationResultUpdated
+		return err
+	})
+	if err != nil {
+		var zero T
+		return zero, "", err
+	}
+	return out, result, nil
+}
+
+// CreateOrPatch fetches the object identified by key. If it does not exist,
+// a new object is built with ops.New, passed to mutate and created.
+// Otherwise, mutate is applied to the existing object and the changes it
+// made are sent with ops.Patch as a JSON merge patch. Nothing is sent if
+// mutate did not change the object. As the patch does not include the
+// resource version, it is applied even if the object was changed since it
+// was fetched. mutate must not change the name or namespace of the object.
+func CreateOrPatch[T Object](ops ObjectFuncs[T], key ObjectKey, mutate func(T) error) (T, OperationResult, error) {
+	var zero T
+	obj, err := ops.Get(key.Namespace, key.Name)
+	if IsNotFoundError(err) {
+		obj = ops.New(key.Namespace, key.Name)
+		if err := mutateKeepingKey(obj, key, mutate); err != nil {
+			return zero, "", err
+		}
+		out, err := ops.Create(key.Namespace, obj)
+		if err != nil {
+			return zero, "", err
+		}
+		return out, OperationResultCreated, nil
+	}
+	if err != nil {
+		return zero, "", err
+	}
+
+	before, err := json.Marshal(obj)
+	if err != nil {
+		return zero, "", errors.Wrap(err, "failed to marshal object")
+	}
+	if err := mutateKeepingKey(obj, key, mutate); err != nil {
+		return zero, "", err
+	}
+	after, err := json.Marshal(obj)
+	if err != nil {
+		return zero, "", errors.Wrap(err, "failed to marshal object")
+	}
+	patch, err := createMergePatch(before, after)
+	if err != nil {
+		return zero, "", err
+	}
+	if patch == nil {
+		return obj, OperationResultNone, nil
+	}
+
+	out, err := ops.Patch(key.Namespace, key.Name, MergePatchType, patch)
+	if err != nil {
+		return zero, "", err
+	}
+	return out, OperationResultUpdated, nil
+}
+
+// mutateKeepingKey calls mutate and returns an error if it changed the name
+// or namespace of the object.
+func mutateKeepingKey[T Object](obj T, key ObjectKey, mutate func(T) error) error {
+	if err := mutate(obj); err != nil {
+		return errors.Wrap(err, "failed to mutate object")
+	}
+	if obj.GetName() != key.Name {
+		return errors.Errorf("mutate changed the name of the object from %q to %q", key.Name, obj.GetName())
+	}
+	if o, ok := Object(obj).(NamespacedObject); ok && o.GetNamespace() != key.Namespace {
+		return errors.Errorf("mutate changed the namespace of the object from %q to %q", key.Namespace, o.GetNamespace())
+	}
+	return nil
+}
+
+// createMergePatch returns the JSON merge patch, as described in RFC 7386,
+// that changes the JSON object before into after. It returns nil if they
+// are the same.
+func createMergePatch(before, after []byte) ([]byte, error) {
+	var b, a map[string]interface{}
+	if err := json.Unmarshal(before, &b); err != nil {
+		return nil, errors.Wrap(err, "failed to decode object")
+	}
+	if err := json.Unmarshal(after, &a); err != nil {
+		return nil, errors.Wrap(err, "failed to decode object")
+	}
+	patch := mergePatchDiff(b, a)
+	if len(patch) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(patch)
+	return data, errors.Wrap(err, "failed to encode patch")
+}
+
+func mergePatchDiff(before, after map[string]interface{}) map[string]interface{} {
+	patch := map[string]interface{}{}
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			// null removes the field
+			patch[k] = nil
+		}
+	}
+	for k, a := range after {
+		b, ok := before[k]
+		if !ok {
+			patch[k] = a
+			continue
+		}
+		bm, bok := b.(map[string]interface{})
+		am, aok := a.(map[string]interface{})
+		if bok && aok {
+			if diff := mergePatchDiff(bm, am); len(diff) > 0 {
+				patch[k] = diff
+			}
+			continue
+		}
+		// lists and values are replaced as a whole
+		if !reflect.DeepEqual(a, b) {
+			patch[k] = a
+		}
+	}
+	return patch
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryOnConflict(t *testing.T) {
+	calls := 0
+	err := RetryOnConflict(Backoff{Steps: 3}, func() error {
+		calls++
+		if calls < 3 {
+			return &Status{Code: 409}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	err = RetryOnConflict(Backoff{Steps: 2}, func() error {
+		calls++
+		return &Status{Code: 409}
+	})
+	assert.True(t, IsConflictError(err))
+	assert.Equal(t, 2, calls)
+
+	calls = 0
+	err = RetryOnConflict(Backoff{Steps: 5}, func() error {
+		calls++
+		return &Status{Code: 404}
+	})
+	assert.True(t, IsNotFoundError(err))
+	assert.Equal(t, 1, calls, "only conflicts are retried")
+}
+
+func TestCreateOrUpdate(t *testing.T) {
+	store := map[ObjectKey]*ConfigMap{}
+	ops := ObjectFuncs[*ConfigMap]{
+		New: NewConfigMap,
+		Get: func(namespace, name string) (*ConfigMap, error) {
+			if cm, ok := store[ObjectKey{namespace, name}]; ok {
+				out := *cm
+				return &out, nil
+			}
+			return nil, &Status{Code: 404}
+		},
+		Create: func(namespace string, item *ConfigMap) (*ConfigMap, error) {
+			store[ObjectKey{namespace, item.Name}] = item
+			return item, nil
+		},
+		Update: func(namespace string, item *ConfigMap) (*ConfigMap, error) {
+			store[ObjectKey{namespace, item.Name}] = item
+			return item, nil
+		},
+	}
+	key := ObjectKey{Namespace: "default", Name: "settings"}
+	mutate := func(cm *ConfigMap) error {
+		cm.Data = map[string]string{"mode": "fast"}
+		return nil
+	}
+
+	out, result, err := CreateOrUpdate(ops, key, mutate)
+	require.Nil(t, err)
+	assert.Equal(t, OperationResultCreated, result)
+	assert.Equal(t, "fast", out.Data["mode"])
+
+	_, result, err = CreateOrUpdate(ops, key, mutate)
+	require.Nil(t, err)
+	assert.Equal(t, OperationResultNone, result)
+
+	_, result, err = CreateOrUpdate(ops, key, func(cm *ConfigMap) error {
+		cm.Data["mode"] = "slow"
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, OperationResultUpdated, result)
+	assert.Equal(t, "slow", store[key].Data["mode"])
+}
+
+func TestCreateOrUpdateKeepsKey(t *testing.T) {
+	ops := ObjectFuncs[*ConfigMap]{
+		New: NewConfigMap,
+		Get: func(namespace, name string) (*ConfigMap, error) {
+			return NewConfigMap(namespace, name), nil
+		},
+		Update: func(namespace string, item *ConfigMap) (*ConfigMap, error) {
+			t.Fatal("object with a changed key should not be updated")
+			return nil, nil
+		},
+	}
+	key := ObjectKey{Namespace: "default", Name: "settings"}
+
+	_, _, err := CreateOrUpdate(ops, key, func(cm *ConfigMap) error {
+		cm.Name = "other"
+		return nil
+	})
+	assert.NotNil(t, err)
+
+	_, _, err = CreateOrUpdate(ops, key, func(cm *ConfigMap) error {
+		cm.Namespace = "kube-system"
+		return nil
+	})
+	assert.NotNil(t, err)
+}
+
+func TestCreateOrPatch(t *testing.T) {
+	store := map[ObjectKey]*ConfigMap{}
+	var patches []string
+	ops := ObjectFuncs[*ConfigMap]{
+		New: NewConfigMap,
+		Get: func(namespace, name string) (*ConfigMap, error) {
+			if cm, ok := store[ObjectKey{namespace, name}]; ok {
+				out := *cm
+				out.Data = map[string]string{}
+				for k, v := range cm.Data {
+					out.Data[k] = v
+				}
+				return &out, nil
+			}
+			return nil, &Status{Code: 404}
+		},
+		Create: func(namespace string, item *ConfigMap) (*ConfigMap, error) {
+			store[ObjectKey{namespace, item.Name}] = item
+			return item, nil
+		},
+		Patch: func(namespace, name string, pt PatchType, data []byte) (*ConfigMap, error) {
+			assert.Equal(t, MergePatchType, pt)
+			patches = append(patches, string(data))
+			return store[ObjectKey{namespace, name}], nil
+		},
+	}
+	key := ObjectKey{Namespace: "default", Name: "settings"}
+
+	out, result, err := CreateOrPatch(ops, key, func(cm *ConfigMap) error {
+		cm.Labels["app"] = "web"
+		cm.Data = map[string]string{"mode": "fast", "size": "large"}
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, OperationResultCreated, result)
+	assert.Equal(t, "fast", out.Data["mode"])
+
+	_, result, err = CreateOrPatch(ops, key, func(cm *ConfigMap) error {
+		cm.Data["mode"] = "fast"
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, OperationResultNone, result)
+	assert.Empty(t, patches)
+
+	_, result, err = CreateOrPatch(ops, key, func(cm *ConfigMap) error {
+		cm.Data["mode"] = "slow"
+		delete(cm.Data, "size")
+		return nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, OperationResultUpdated, result)
+	require.Len(t, patches, 1)
+	assert.JSONEq(t, `{"data":{"mode":"slow","size":null}}`, patches[0])
+
+	_, _, err = CreateOrPatch(ops, key, func(cm *ConfigMap) error {
+		cm.Name = "other"
+		return nil
+	})
+	assert.NotNil(t, err)
+	assert.Len(t, patches, 1)
+}
@@ -0,0 +1,266 @@
+package rollout
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+const (
+	// RevisionAnnotation is set by the deployment controller on each ReplicaSet
+	// it manages to record the rollout revision.
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+	// ChangeCauseAnnotation records why a revision was rolled out.
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+	// RestartedAtAnnotation is stamped on the pod template to trigger a restart.
+	RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+	// podTemplateHashLabel is added by the deployment controller to the pods
+	// of each ReplicaSet and must not be copied back to the Deployment.
+	podTemplateHashLabel = "pod-template-hash"
+)
+
+type (
+	// Client is the subset of the Kubernetes client used to manage rollouts.
+	Client interface {
+		k8s.DeploymentInterface
+		k8s.ReplicaSetInterface
+	}
+
+	// Revision is a single entry in the rollout history of a Deployment.
+	Revision struct {
+		// Revision is the rollout revision number.
+		Revision int64
+		// ChangeCause is the recorded reason for the revision, if any.
+		ChangeCause string
+		// ReplicaSet is the ReplicaSet that runs this revision.
+		ReplicaSet *k8s.ReplicaSet
+		// Template is the pod template of this revision.
+		Template *k8s.PodTemplateSpec
+	}
+)
+
+// History returns the rollout history of the Deployment ordered from oldest
+// to newest revision. It is built from the ReplicaSets owned by the Deployment.
+func History(c Client, namespace, name string) ([]Revision, error) {
+	d, err := c.GetDeployment(namespace, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get deployment")
+	}
+	return history(c, d)
+}
+
+func history(c Client, d *k8s.Deployment) ([]Revision, error) {
+	if d.Spec == nil || d.Spec.Selector == nil ||
+		(len(d.Spec.Selector.MatchLabels) == 0 && len(d.Spec.Selector.MatchExpressions) == 0) {
+		return nil, errors.Errorf("deployment %q has no selector", d.Name)
+	}
+
+	opts := &k8s.ListOptions{
+		LabelSelector: *d.Spec.Selector,
+	}
+	list, err := c.ListReplicaSets(d.Namespace, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list replica sets")
+	}
+
+	var revisions []Revision
+	for i := range list.Items {
+		rs := &list.Items[i]
+		// the selector may match ReplicaSets owned by another Deployment
+		if ref := k8s.GetControllerOf(rs); ref != nil && ref.UID != d.UID {
+			continue
+		}
+		value, ok := rs.Annotations[RevisionAnnotation]
+		if !ok {
+			continue
+		}
+		revision, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		r := Revision{
+			Revision:    revision,
+			ChangeCause: rs.Annotations[ChangeCauseAnnotation],
+			ReplicaSet:  rs,
+		}
+		if rs.Spec != nil {
+			r.Template = rs.Spec.Template
+		}
+		revisions = append(revisions, r)
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+	return revisions, nil
+}
+
+// Undo rolls the Deployment back to the pod template of the given revision.
+// A revision of 0 rolls back to the previous revision. The whole template of
+// the revision's ReplicaSet is restored, including any fields this package
+// does not model, as kubectl rollout undo does.
+func Undo(c Client, namespace, name string, revision int64) (*k8s.Deployment, error) {
+	var out *k8s.Deployment
+	err := k8s.RetryOnConflict(k8s.DefaultRetry, func() error {
+		d, err := c.GetDeployment(namespace, name)
+		if err != nil {
+			return errors.Wrap(err, "failed to get deployment")
+		}
+
+		revisions, err := history(c, d)
+		if err != nil {
+			return err
+		}
+		target, err := findRevision(revisions, name, revision)
+		if err != nil {
+			return err
+		}
+
+		rolledBack, err := withTemplateOf(d, target.ReplicaSet)
+		if err != nil {
+			return errors.Wrapf(err, "failed to roll back to revision %d", target.Revision)
+		}
+		out, err = c.UpdateDeployment(namespace, rolledBack)
+		return errors.Wrapf(err, "failed to roll back to revision %d", target.Revision)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// findRevision returns the given revision, or the one before the newest if
+// revision is 0.
+func findRevision(revisions []Revision, name string, revision int64) (*Revision, error) {
+	if revision == 0 {
+		// the newest revision is the current one
+		if len(revisions) < 2 {
+			return nil, errors.Errorf("deployment %q has no previous revision", name)
+		}
+		return &revisions[len(revisions)-2], nil
+	}
+	for i := range revisions {
+		if revisions[i].Revision == revision {
+			return &revisions[i], nil
+		}
+	}
+	return nil, errors.Errorf("deployment %q has no revision %d", name, revision)
+}
+
+// withTemplateOf returns a copy of the Deployment with its pod template
+// replaced by the JSON of the ReplicaSet's, less the pod-template-hash label.
+// Working on the JSON keeps the fields of the template that are not modelled
+// by PodTemplateSpec, which would otherwise come from the Deployment.
+func withTemplateOf(d *k8s.Deployment, rs *k8s.ReplicaSet) (*k8s.Deployment, error) {
+	var source struct {
+		Spec struct {
+			Template map[string]interface{} `json:"template"`
+		} `json:"spec"`
+	}
+	if err := remarshal(rs, &source); err != nil {
+		return nil, err
+	}
+	template := source.Spec.Template
+	if template == nil {
+		return nil, errors.Errorf("replica set %q has no pod template", rs.Name)
+	}
+	if metadata, ok := template["metadata"].(map[string]interface{}); ok {
+		if labels, ok := metadata["labels"].(map[string]interface{}); ok {
+			delete(labels, podTemplateHashLabel)
+		}
+	}
+
+	var obj map[string]interface{}
+	if err := remarshal(d, &obj); err != nil {
+		return nil, err
+	}
+	spec, ok := obj["spec"].(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("deployment %q has no spec", d.Name)
+	}
+	spec["template"] = template
+
+	var out k8s.Deployment
+	if err := remarshal(obj, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// remarshal converts in to out through JSON.
+func remarshal(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+// Pause marks the Deployment as paused. Changes to a paused Deployment are
+// not rolled out until it is resumed.
+func Pause(c k8s.DeploymentInterface, namespace, name string) (*k8s.Deployment, error) {
+	return setPaused(c, namespace, name, true)
+}
+
+// Resume resumes a paused Deployment.
+func Resume(c k8s.DeploymentInterface, namespace, name string) (*k8s.Deployment, error) {
+	return setPaused(c, namespace, name, false)
+}
+
+func setPaused(c k8s.DeploymentInterface, namespace, name string, paused bool) (*k8s.Deployment, error) {
+	var out *k8s.Deployment
+	err := k8s.RetryOnConflict(k8s.DefaultRetry, func() error {
+		d, err := c.GetDeployment(namespace, name)
+		if err != nil {
+			return errors.Wrap(err, "failed to get deployment")
+		}
+		if d.Spec == nil {
+			return errors.Errorf("deployment %q has no spec", name)
+		}
+		if d.Spec.Paused == paused {
+			out = d
+			return nil
+		}
+		d.Spec.Paused = paused
+
+		out, err = c.UpdateDeployment(namespace, d)
+		return errors.Wrap(err, "failed to update deployment")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Restart triggers a new rollout of the Deployment without changing its
+// configuration by stamping the current time on the pod template.
+func Restart(c k8s.DeploymentInterface, namespace, name string) (*k8s.Deployment, error) {
+	restartedAt := time.Now().UTC().Format(time.RFC3339)
+
+	var out *k8s.Deployment
+	err := k8s.RetryOnConflict(k8s.DefaultRetry, func() error {
+		d, err := c.GetDeployment(namespace, name)
+		if err != nil {
+			return errors.Wrap(err, "failed to get deployment")
+		}
+		if d.Spec == nil {
+			return errors.Errorf("deployment %q has no spec", name)
+		}
+		if d.Spec.Template.Annotations == nil {
+			d.Spec.Template.Annotations = make(map[string]string)
+		}
+		d.Spec.Template.Annotations[RestartedAtAnnotation] = restartedAt
+
+		out, err = c.UpdateDeployment(namespace, d)
+		return errors.Wrap(err, "failed to update deployment")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
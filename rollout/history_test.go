@@ -0,0 +1,205 @@
+package rollout
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeployment(t *testing.T, c *fake.Client, selector *k8s.LabelSelector) *k8s.Deployment {
+	d := k8s.NewDeployment("default", "web")
+	d.Spec.Selector = selector
+	d.Spec.Template.Labels = map[string]string{"app": "web"}
+	d.Spec.Template.Spec = &k8s.PodSpec{Containers: []k8s.Container{{Name: "web", Image: "web:3"}}}
+	d, err := c.CreateDeployment("default", d)
+	require.Nil(t, err)
+	return d
+}
+
+// newReplicaSet creates a ReplicaSet owned by d for a revision. Its template
+// has tolerations, which PodSpec does not model.
+func newReplicaSet(t *testing.T, c *fake.Client, d *k8s.Deployment, revision int) *k8s.ReplicaSet {
+	ref, err := k8s.NewControllerRef(d)
+	require.Nil(t, err)
+	refJSON, err := json.Marshal(ref)
+	require.Nil(t, err)
+
+	data := fmt.Sprintf(`{
+  "metadata": {
+    "name": "web-%[1]d",
+    "namespace": "default",
+    "labels": {"app": "web", "pod-template-hash": "hash%[1]d"},
+    "annotations": {%[2]q: "%[1]d", %[3]q: "release %[1]d"},
+    "ownerReferences": [%[4]s]
+  },
+  "spec": {
+    "replicas": 1,
+    "template": {
+      "metadata": {"labels": {"app": "web", "pod-template-hash": "hash%[1]d"}},
+      "spec": {
+        "containers": [{"name": "web", "image": "web:%[1]d"}],
+        "tolerations": [{"key": "revision", "value": "%[1]d"}]
+      }
+    }
+  }
+}`, revision, RevisionAnnotation, ChangeCauseAnnotation, refJSON)
+
+	var rs k8s.ReplicaSet
+	require.Nil(t, json.Unmarshal([]byte(data), &rs))
+	created, err := c.CreateReplicaSet("default", &rs)
+	require.Nil(t, err)
+	return created
+}
+
+func TestHistory(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	d := newDeployment(t, c, &k8s.LabelSelector{MatchLabels: map[string]string{"app": "web"}})
+	for _, revision := range []int{3, 1, 2} {
+		newReplicaSet(t, c, d, revision)
+	}
+
+	// a ReplicaSet the selector matches but another Deployment owns
+	other := k8s.NewReplicaSet("default", "other")
+	other.Labels = map[string]string{"app": "web"}
+	other.Annotations = map[string]string{RevisionAnnotation: "9"}
+	other.OwnerReferences = []k8s.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "other", UID: "other", Controller: &[]bool{true}[0]}}
+	_, err = c.CreateReplicaSet("default", other)
+	require.Nil(t, err)
+
+	revisions, err := History(c, "default", "web")
+	require.Nil(t, err)
+	require.Len(t, revisions, 3)
+	for i, r := range revisions {
+		assert.Equal(t, int64(i+1), r.Revision)
+		assert.Equal(t, fmt.Sprintf("release %d", i+1), r.ChangeCause)
+		assert.Equal(t, fmt.Sprintf("web-%d", i+1), r.ReplicaSet.Name)
+		require.NotNil(t, r.Template)
+	}
+}
+
+func TestHistoryMatchExpressions(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	d := newDeployment(t, c, &k8s.LabelSelector{
+		MatchExpressions: []k8s.LabelSelectorRequirement{
+			{Key: "app", Operator: k8s.LabelSelectorOpIn, Values: []string{"web"}},
+		},
+	})
+	for revision := 1; revision <= 2; revision++ {
+		newReplicaSet(t, c, d, revision)
+	}
+
+	// a ReplicaSet the selector does not match
+	other := k8s.NewReplicaSet("default", "api")
+	other.Labels = map[string]string{"app": "api"}
+	other.Annotations = map[string]string{RevisionAnnotation: "5"}
+	_, err = c.CreateReplicaSet("default", other)
+	require.Nil(t, err)
+
+	revisions, err := History(c, "default", "web")
+	require.Nil(t, err)
+	require.Len(t, revisions, 2)
+	assert.Equal(t, "web-1", revisions[0].ReplicaSet.Name)
+	assert.Equal(t, "web-2", revisions[1].ReplicaSet.Name)
+}
+
+// templateOf returns the pod template of the Deployment as JSON.
+func templateOf(t *testing.T, d *k8s.Deployment) map[string]interface{} {
+	var obj struct {
+		Spec struct {
+			Template map[string]interface{} `json:"template"`
+		} `json:"spec"`
+	}
+	data, err := json.Marshal(d)
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal(data, &obj))
+	return obj.Spec.Template
+}
+
+func TestUndo(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	d := newDeployment(t, c, &k8s.LabelSelector{MatchLabels: map[string]string{"app": "web"}})
+	for revision := 1; revision <= 3; revision++ {
+		newReplicaSet(t, c, d, revision)
+	}
+
+	d, err = Undo(c, "default", "web", 0)
+	require.Nil(t, err)
+	assert.Equal(t, "web:2", d.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, map[string]string{"app": "web"}, d.Spec.Template.Labels, "pod-template-hash should be removed")
+	assert.Equal(t, []interface{}{map[string]interface{}{"key": "revision", "value": "2"}},
+		templateOf(t, d)["spec"].(map[string]interface{})["tolerations"],
+		"fields of the template that are not modelled should come from the revision")
+
+	d, err = Undo(c, "default", "web", 1)
+	require.Nil(t, err)
+	assert.Equal(t, "web:1", d.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, map[string]string{"app": "web"}, d.Spec.Template.Labels)
+
+	got, err := c.GetDeployment("default", "web")
+	require.Nil(t, err)
+	assert.Equal(t, "web:1", got.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, []interface{}{map[string]interface{}{"key": "revision", "value": "1"}},
+		templateOf(t, got)["spec"].(map[string]interface{})["tolerations"])
+
+	_, err = Undo(c, "default", "web", 7)
+	assert.EqualError(t, err, `deployment "web" has no revision 7`)
+}
+
+func TestUndoNoPreviousRevision(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	d := newDeployment(t, c, &k8s.LabelSelector{MatchLabels: map[string]string{"app": "web"}})
+	newReplicaSet(t, c, d, 1)
+
+	_, err = Undo(c, "default", "web", 0)
+	assert.EqualError(t, err, `deployment "web" has no previous revision`)
+}
+
+func TestPauseResume(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	newDeployment(t, c, &k8s.LabelSelector{MatchLabels: map[string]string{"app": "web"}})
+
+	d, err := Pause(c, "default", "web")
+	require.Nil(t, err)
+	assert.True(t, d.Spec.Paused)
+
+	d, err = Pause(c, "default", "web")
+	require.Nil(t, err)
+	assert.True(t, d.Spec.Paused, "pausing twice should leave it paused")
+
+	d, err = Resume(c, "default", "web")
+	require.Nil(t, err)
+	assert.False(t, d.Spec.Paused)
+
+	got, err := c.GetDeployment("default", "web")
+	require.Nil(t, err)
+	assert.False(t, got.Spec.Paused)
+}
+
+func TestRestart(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	newDeployment(t, c, &k8s.LabelSelector{MatchLabels: map[string]string{"app": "web"}})
+
+	before := time.Now().UTC().Truncate(time.Second)
+	d, err := Restart(c, "default", "web")
+	require.Nil(t, err)
+
+	restartedAt, err := time.Parse(time.RFC3339, d.Spec.Template.Annotations[RestartedAtAnnotation])
+	require.Nil(t, err)
+	assert.False(t, restartedAt.Before(before))
+
+	got, err := c.GetDeployment("default", "web")
+	require.Nil(t, err)
+	assert.Equal(t, d.Spec.Template.Annotations, got.Spec.Template.Annotations)
+}
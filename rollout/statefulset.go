@@ -0,0 +1,307 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+type (
+	// StatefulSetClient is the subset of the Kubernetes client used to
+	// manage StatefulSet rollouts.
+	StatefulSetClient interface {
+		k8s.StatefulSetInterface
+		k8s.PodInterface
+	}
+
+	// Ordinal is the rollout state of the pod with a single ordinal of a
+	// StatefulSet.
+	Ordinal struct {
+		// Ordinal is the index of the pod in the StatefulSet.
+		Ordinal int32
+		// Pod is the pod with this ordinal, or nil if it does not exist.
+		Pod *k8s.Pod
+		// Revision is the StatefulSet revision the pod was created from.
+		Revision string
+		// Updated is true if the pod runs the update revision of the StatefulSet.
+		Updated bool
+		// Ready is true if the pod has a Ready condition with status True and
+		// is not being deleted.
+		Ready bool
+	}
+)
+
+// Complete reports whether the pod exists, runs the update revision and is ready.
+func (o *Ordinal) Complete() bool {
+	return o.Pod != nil && o.Updated && o.Ready
+}
+
+// StatefulSetStatus computes the rollout state of the StatefulSet. With a
+// partitioned rolling update, the rollout is complete once every pod with
+// an ordinal at or above the partition has been updated. With the OnDelete
+// strategy pods are only updated when they are deleted, so the rollout is
+// complete once every replica is ready.
+func StatefulSetStatus(s *k8s.StatefulSet) *Status {
+	st := &Status{
+		Generation: s.Generation,
+	}
+	if s.Spec != nil {
+		st.DesiredReplicas = int(s.Spec.Replicas)
+	}
+
+	if s.Status == nil || s.Status.ObservedGeneration < s.Generation {
+		st.Message = fmt.Sprintf("waiting for statefulset %q spec update to be observed", s.Name)
+		return st
+	}
+
+	st.ObservedGeneration = s.Status.ObservedGeneration
+	st.Replicas = int(s.Status.Replicas)
+	st.UpdatedReplicas = int(s.Status.UpdatedReplicas)
+	st.AvailableReplicas = int(s.Status.ReadyReplicas)
+	if st.DesiredReplicas > st.AvailableReplicas {
+		st.UnavailableReplicas = st.DesiredReplicas - st.AvailableReplicas
+	}
+
+	partition := int(s.Partition())
+	switch {
+	case st.AvailableReplicas < st.DesiredReplicas:
+		st.Message = fmt.Sprintf("waiting for statefulset %q rollout to finish: %d of %d pods are ready",
+			s.Name, st.AvailableReplicas, st.DesiredReplicas)
+	case s.Spec != nil && s.Spec.UpdateStrategy.Type == k8s.OnDeleteStatefulSetStrategyType:
+		st.Complete = true
+		st.Message = fmt.Sprintf("statefulset %q uses the OnDelete strategy: %d pods are ready", s.Name, st.AvailableReplicas)
+	case partition > 0:
+		if want := st.DesiredReplicas - partition; st.UpdatedReplicas < want {
+			st.Message = fmt.Sprintf("waiting for statefulset %q partitioned rollout to finish: %d out of %d new pods have been updated",
+				s.Name, st.UpdatedReplicas, want)
+			break
+		}
+		st.Complete = true
+		st.Message = fmt.Sprintf("statefulset %q partitioned rollout complete: %d new pods have been updated", s.Name, st.UpdatedReplicas)
+	case s.Status.UpdateRevision != s.Status.CurrentRevision:
+		st.Message = fmt.Sprintf("waiting for statefulset %q rollout to finish: %d out of %d new pods have been updated",
+			s.Name, st.UpdatedReplicas, st.DesiredReplicas)
+	default:
+		st.Complete = true
+		st.Message = fmt.Sprintf("statefulset %q successfully rolled out", s.Name)
+	}
+	return st
+}
+
+// WaitForStatefulSetRollout blocks until the StatefulSet has rolled out or
+// the context is done. If progress is not nil, it is called each time the
+// rollout state changes.
+func WaitForStatefulSetRollout(ctx context.Context, c k8s.StatefulSetInterface, namespace, name string, progress func(*Status)) (*Status, error) {
+	var last *Status
+	condition := func(obj k8s.Object) (bool, error) {
+		if obj == nil {
+			return false, errors.Errorf("statefulset %q not found", name)
+		}
+		s, ok := obj.(*k8s.StatefulSet)
+		if !ok {
+			return false, errors.Errorf("expected a StatefulSet, got %T", obj)
+		}
+
+		st := StatefulSetStatus(s)
+		if progress != nil && (last == nil || *last != *st) {
+			progress(st)
+		}
+		last = st
+		return st.Complete, nil
+	}
+
+	_, err := k8s.WaitFor(ctx, k8s.StatefulSetListWatcher(c, namespace, name), condition)
+	return last, err
+}
+
+// Ordinals returns the rollout state of each pod of the StatefulSet,
+// indexed by ordinal. Ordinals without a pod have a nil Pod.
+func Ordinals(c StatefulSetClient, namespace, name string) ([]Ordinal, error) {
+	s, err := c.GetStatefulSet(namespace, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get statefulset")
+	}
+	return ordinals(c, s)
+}
+
+func ordinals(c StatefulSetClient, s *k8s.StatefulSet) ([]Ordinal, error) {
+	if s.Spec == nil || s.Spec.Selector == nil ||
+		(len(s.Spec.Selector.MatchLabels) == 0 && len(s.Spec.Selector.MatchExpressions) == 0) {
+		return nil, errors.Errorf("statefulset %q has no selector", s.Name)
+	}
+	var updateRevision string
+	if s.Status != nil {
+		updateRevision = s.Status.UpdateRevision
+	}
+
+	list, err := c.ListPods(s.Namespace, &k8s.ListOptions{LabelSelector: *s.Spec.Selector})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list pods")
+	}
+
+	result := make([]Ordinal, s.Spec.Replicas)
+	for i := range result {
+		result[i].Ordinal = int32(i)
+	}
+	for i := range list.Items {
+		pod := &list.Items[i]
+		if ref := k8s.GetControllerOf(pod); ref == nil || ref.UID != s.UID {
+			continue
+		}
+		ordinal, ok := podOrdinal(s.Name, pod.Name)
+		if !ok || ordinal >= int32(len(result)) {
+			// pods above the replica count are being scaled down
+			continue
+		}
+		o := Ordinal{
+			Ordinal:  ordinal,
+			Pod:      pod,
+			Revision: pod.Labels[k8s.StatefulSetRevisionLabel],
+		}
+		o.Updated = updateRevision != "" && o.Revision == updateRevision
+		o.Ready = podReady(pod)
+		result[ordinal] = o
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Ordinal < result[j].Ordinal
+	})
+	return result, nil
+}
+
+// SetPartition sets the partition of the StatefulSet's rolling update. Only
+// pods with an ordinal at or above the partition are updated when the pod
+// template changes.
+func SetPartition(c k8s.StatefulSetInterface, namespace, name string, partition int32) (*k8s.StatefulSet, error) {
+	var out *k8s.StatefulSet
+	err := k8s.RetryOnConflict(k8s.DefaultRetry, func() error {
+		s, err := c.GetStatefulSet(namespace, name)
+		if err != nil {
+			return errors.Wrap(err, "failed to get statefulset")
+		}
+		if s.Spec == nil {
+			return errors.Errorf("statefulset %q has no spec", name)
+		}
+		if s.Spec.UpdateStrategy.Type == k8s.OnDeleteStatefulSetStrategyType {
+			return errors.Errorf("statefulset %q uses the OnDelete strategy", name)
+		}
+		if s.Spec.UpdateStrategy.RollingUpdate != nil && s.Spec.UpdateStrategy.RollingUpdate.Partition != nil &&
+			*s.Spec.UpdateStrategy.RollingUpdate.Partition == partition {
+			out = s
+			return nil
+		}
+		s.Spec.UpdateStrategy.Type = k8s.RollingUpdateStatefulSetStrategyType
+		s.Spec.UpdateStrategy.RollingUpdate = &k8s.RollingUpdateStatefulSetStrategy{
+			Partition: &partition,
+		}
+
+		out, err = c.UpdateStatefulSet(namespace, s)
+		return errors.Wrap(err, "failed to update statefulset")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CanaryRollout drives a partitioned rollout of the StatefulSet one ordinal
+// at a time. The pod template should already have been changed while the
+// partition was at or above the number of replicas, so that no pod has been
+// updated yet.
+//
+// Starting from the highest ordinal, the partition is lowered by one and the
+// rollout waits until the pod with that ordinal runs the new revision and is
+// ready. Before each step proceed, if not nil, is called with the ordinal
+// about to be updated; returning an error stops the rollout with the
+// partition left where it is, so that the canary pods can be inspected or
+// the template reverted.
+func CanaryRollout(ctx context.Context, c StatefulSetClient, namespace, name string, proceed func(ordinal int32) error) (*k8s.StatefulSet, error) {
+	s, err := c.GetStatefulSet(namespace, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get statefulset")
+	}
+	if s.Spec == nil {
+		return nil, errors.Errorf("statefulset %q has no spec", name)
+	}
+
+	partition := s.Partition()
+	if partition > s.Spec.Replicas {
+		partition = s.Spec.Replicas
+	}
+	for ordinal := partition - 1; ordinal >= 0; ordinal-- {
+		if proceed != nil {
+			if err := proceed(ordinal); err != nil {
+				return s, errors.Wrapf(err, "statefulset %q rollout stopped at ordinal %d", name, ordinal)
+			}
+		}
+		if _, err := SetPartition(c, namespace, name, ordinal); err != nil {
+			return nil, err
+		}
+		if s, err = waitForOrdinal(ctx, c, namespace, name, ordinal); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// waitForOrdinal waits until the StatefulSet has observed its latest spec
+// and the pod with the given ordinal runs the update revision and is ready.
+func waitForOrdinal(ctx context.Context, c StatefulSetClient, namespace, name string, ordinal int32) (*k8s.StatefulSet, error) {
+	obj, err := k8s.WaitFor(ctx, k8s.StatefulSetListWatcher(c, namespace, name), func(obj k8s.Object) (bool, error) {
+		if obj == nil {
+			return false, errors.Errorf("statefulset %q not found", name)
+		}
+		s, ok := obj.(*k8s.StatefulSet)
+		if !ok {
+			return false, errors.Errorf("expected a StatefulSet, got %T", obj)
+		}
+		return s.Status != nil && s.Status.ObservedGeneration >= s.Generation && s.Status.UpdateRevision != "", nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed waiting for statefulset %q", name)
+	}
+	s := obj.(*k8s.StatefulSet)
+	revision := s.Status.UpdateRevision
+
+	podName := fmt.Sprintf("%s-%d", name, ordinal)
+	_, err = k8s.WaitFor(ctx, k8s.PodListWatcher(c, namespace, podName), func(obj k8s.Object) (bool, error) {
+		pod, ok := obj.(*k8s.Pod)
+		if !ok || pod == nil {
+			return false, nil
+		}
+		return pod.Labels[k8s.StatefulSetRevisionLabel] == revision && podReady(pod), nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed waiting for pod %q", podName)
+	}
+	return s, nil
+}
+
+// podOrdinal parses the ordinal from the name of a pod of the StatefulSet.
+func podOrdinal(setName, podName string) (int32, bool) {
+	if !strings.HasPrefix(podName, setName+"-") {
+		return 0, false
+	}
+	ordinal, err := strconv.ParseInt(strings.TrimPrefix(podName, setName+"-"), 10, 32)
+	if err != nil || ordinal < 0 {
+		return 0, false
+	}
+	return int32(ordinal), true
+}
+
+func podReady(pod *k8s.Pod) bool {
+	if pod.DeletionTimestamp != nil || pod.Status == nil {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == k8s.PodReady && c.Status == k8s.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
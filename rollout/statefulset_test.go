@@ -0,0 +1,178 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/fake"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatefulSetStatus(t *testing.T) {
+	s := k8s.NewStatefulSet("default", "db")
+	s.Generation = 2
+	s.Spec.Replicas = 3
+	s.Status = &k8s.StatefulSetStatus{ObservedGeneration: 1}
+
+	st := StatefulSetStatus(s)
+	assert.False(t, st.Complete, "new generation has not been observed")
+
+	s.Status = &k8s.StatefulSetStatus{
+		ObservedGeneration: 2,
+		Replicas:           3,
+		ReadyReplicas:      2,
+		CurrentRevision:    "db-1",
+		UpdateRevision:     "db-2",
+	}
+	st = StatefulSetStatus(s)
+	assert.False(t, st.Complete, "not all pods are ready")
+	assert.Equal(t, 1, st.UnavailableReplicas)
+
+	s.Status.ReadyReplicas = 3
+	s.Status.UpdatedReplicas = 1
+	st = StatefulSetStatus(s)
+	assert.False(t, st.Complete, "not all pods are updated")
+
+	partition := int32(2)
+	s.Spec.UpdateStrategy.RollingUpdate = &k8s.RollingUpdateStatefulSetStrategy{Partition: &partition}
+	st = StatefulSetStatus(s)
+	assert.True(t, st.Complete, "pods above the partition are updated")
+
+	s.Spec.UpdateStrategy.RollingUpdate = nil
+	s.Status.UpdatedReplicas = 3
+	s.Status.CurrentRevision = "db-2"
+	st = StatefulSetStatus(s)
+	assert.True(t, st.Complete)
+}
+
+// newStatefulSet creates a StatefulSet and its pods at revision "old".
+func newStatefulSet(t *testing.T, c *fake.Client, replicas int32) *k8s.StatefulSet {
+	s := k8s.NewStatefulSet("default", "db")
+	s.Spec.Replicas = replicas
+	s.Spec.Selector = &k8s.LabelSelector{MatchLabels: map[string]string{"app": "db"}}
+	s.Spec.UpdateStrategy.RollingUpdate = &k8s.RollingUpdateStatefulSetStrategy{Partition: &replicas}
+	s, err := c.CreateStatefulSet("default", s)
+	require.Nil(t, err)
+	s.Status = &k8s.StatefulSetStatus{ObservedGeneration: s.Generation, CurrentRevision: "old", UpdateRevision: "old"}
+	s, err = c.UpdateStatefulSetStatus("default", s)
+	require.Nil(t, err)
+
+	ref, err := k8s.NewControllerRef(s)
+	require.Nil(t, err)
+	for i := int32(0); i < replicas; i++ {
+		pod := &k8s.Pod{ObjectMeta: k8s.NewObjectMeta("default", fmt.Sprintf("db-%d", i))}
+		pod.Labels = map[string]string{"app": "db", k8s.StatefulSetRevisionLabel: "old"}
+		pod.OwnerReferences = []k8s.OwnerReference{*ref}
+		pod.Status = &k8s.PodStatus{Conditions: []k8s.PodCondition{{Type: k8s.PodReady, Status: k8s.ConditionTrue}}}
+		_, err := c.CreatePod("default", pod)
+		require.Nil(t, err)
+	}
+	return s
+}
+
+func TestOrdinals(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	s := newStatefulSet(t, c, 3)
+
+	s.Status.UpdateRevision = "new"
+	_, err = c.UpdateStatefulSetStatus("default", s)
+	require.Nil(t, err)
+	pod, err := c.GetPod("default", "db-2")
+	require.Nil(t, err)
+	pod.Labels[k8s.StatefulSetRevisionLabel] = "new"
+	_, err = c.UpdatePod("default", pod)
+	require.Nil(t, err)
+	require.Nil(t, c.DeletePod("default", "db-1"))
+
+	ordinals, err := Ordinals(c, "default", "db")
+	require.Nil(t, err)
+	require.Len(t, ordinals, 3)
+	assert.False(t, ordinals[0].Updated)
+	assert.True(t, ordinals[0].Ready)
+	assert.Nil(t, ordinals[1].Pod)
+	assert.False(t, ordinals[1].Complete())
+	assert.Equal(t, "new", ordinals[2].Revision)
+	assert.True(t, ordinals[2].Complete())
+
+	s, err = c.GetStatefulSet("default", "db")
+	require.Nil(t, err)
+	s.Spec.Selector = &k8s.LabelSelector{MatchExpressions: []k8s.LabelSelectorRequirement{
+		{Key: "app", Operator: k8s.LabelSelectorOpIn, Values: []string{"db"}},
+	}}
+	_, err = c.UpdateStatefulSet("default", s)
+	require.Nil(t, err)
+	ordinals, err = Ordinals(c, "default", "db")
+	require.Nil(t, err, "selectors with only expressions are allowed")
+	assert.NotNil(t, ordinals[0].Pod)
+
+	s, err = c.GetStatefulSet("default", "db")
+	require.Nil(t, err)
+	s.Spec.Selector = &k8s.LabelSelector{}
+	_, err = c.UpdateStatefulSet("default", s)
+	require.Nil(t, err)
+	_, err = Ordinals(c, "default", "db")
+	assert.NotNil(t, err)
+}
+
+func TestCanaryRollout(t *testing.T) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	newStatefulSet(t, c, 3)
+
+	// stand in for the StatefulSet controller
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	go func() {
+		for ctx.Err() == nil {
+			time.Sleep(10 * time.Millisecond)
+			s, err := c.GetStatefulSet("default", "db")
+			if err != nil || s.Status.ObservedGeneration >= s.Generation {
+				continue
+			}
+			s.Status.ObservedGeneration = s.Generation
+			s.Status.UpdateRevision = "new"
+			if _, err := c.UpdateStatefulSetStatus("default", s); err != nil {
+				continue
+			}
+			for i := s.Partition(); i < s.Spec.Replicas; i++ {
+				pod, err := c.GetPod("default", fmt.Sprintf("db-%d", i))
+				if err != nil {
+					continue
+				}
+				pod.Labels[k8s.StatefulSetRevisionLabel] = "new"
+				_, _ = c.UpdatePod("default", pod)
+			}
+		}
+	}()
+
+	var steps []int32
+	_, err = CanaryRollout(ctx, c, "default", "db", func(ordinal int32) error {
+		steps = append(steps, ordinal)
+		if ordinal == 0 {
+			return errors.New("canary failed")
+		}
+		return nil
+	})
+	require.NotNil(t, err)
+	assert.Equal(t, []int32{2, 1, 0}, steps)
+
+	s, err := c.GetStatefulSet("default", "db")
+	require.Nil(t, err)
+	assert.Equal(t, int32(1), s.Partition(), "a stopped rollout keeps its partition")
+
+	ordinals, err := Ordinals(c, "default", "db")
+	require.Nil(t, err)
+	assert.False(t, ordinals[0].Updated)
+	assert.True(t, ordinals[1].Complete())
+	assert.True(t, ordinals[2].Complete())
+
+	s, err = CanaryRollout(ctx, c, "default", "db", nil)
+	require.Nil(t, err)
+	assert.Equal(t, int32(0), s.Partition())
+}
@@ -0,0 +1,121 @@
+// Package rollout provides helpers for following and managing Deployment and
+// StatefulSet rollouts.
+package rollout
+
+import (
+	"context"
+	"fmt"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/pkg/errors"
+)
+
+// ErrProgressDeadlineExceeded is returned when a Deployment has not made
+// progress within its spec.progressDeadlineSeconds.
+var ErrProgressDeadlineExceeded = errors.New("progress deadline exceeded")
+
+type (
+	// Status is the rollout state of a Deployment.
+	Status struct {
+		// Complete is true once every replica has been updated and is available.
+		Complete bool
+		// Failed is true if the Deployment exceeded its progress deadline.
+		Failed bool
+		// Message is a human readable description of the rollout state.
+		Message string
+
+		Generation          int64
+		ObservedGeneration  int64
+		DesiredReplicas     int
+		Replicas            int
+		UpdatedReplicas     int
+		AvailableReplicas   int
+		UnavailableReplicas int
+	}
+)
+
+// DeploymentStatus computes the rollout state of the Deployment.
+func DeploymentStatus(d *k8s.Deployment) *Status {
+	s := &Status{
+		Generation: d.Generation,
+	}
+	if d.Spec != nil {
+		s.DesiredReplicas = d.Spec.Replicas
+	}
+
+	if d.Status == nil || d.Status.ObservedGeneration < d.Generation {
+		s.Message = fmt.Sprintf("waiting for deployment %q spec update to be observed", d.Name)
+		return s
+	}
+
+	s.ObservedGeneration = d.Status.ObservedGeneration
+	s.Replicas = d.Status.Replicas
+	s.UpdatedReplicas = d.Status.UpdatedReplicas
+	s.AvailableReplicas = d.Status.AvailableReplicas
+	s.UnavailableReplicas = d.Status.UnavailableReplicas
+
+	if c := getCondition(d.Status, k8s.DeploymentProgressing); c != nil && c.Reason == k8s.TimedOutReason {
+		s.Failed = true
+		s.Message = fmt.Sprintf("deployment %q exceeded its progress deadline", d.Name)
+		return s
+	}
+
+	switch {
+	case s.UpdatedReplicas < s.DesiredReplicas:
+		s.Message = fmt.Sprintf("waiting for deployment %q rollout to finish: %d out of %d new replicas have been updated",
+			d.Name, s.UpdatedReplicas, s.DesiredReplicas)
+	case s.Replicas > s.UpdatedReplicas:
+		s.Message = fmt.Sprintf("waiting for deployment %q rollout to finish: %d old replicas are pending termination",
+			d.Name, s.Replicas-s.UpdatedReplicas)
+	case s.AvailableReplicas < s.UpdatedReplicas:
+		s.Message = fmt.Sprintf("waiting for deployment %q rollout to finish: %d of %d updated replicas are available",
+			d.Name, s.AvailableReplicas, s.UpdatedReplicas)
+	default:
+		s.Complete = true
+		s.Message = fmt.Sprintf("deployment %q successfully rolled out", d.Name)
+	}
+	return s
+}
+
+// WaitForRollout blocks until the Deployment has rolled out, has exceeded its
+// progress deadline, or the context is done. It watches the Deployment rather
+// than polling. If progress is not nil, it is called each time the rollout
+// state changes.
+//
+// When the progress deadline is exceeded, the final status is returned along
+// with an error whose cause is ErrProgressDeadlineExceeded.
+func WaitForRollout(ctx context.Context, c k8s.DeploymentInterface, namespace, name string, progress func(*Status)) (*Status, error) {
+	var last *Status
+	condition := func(obj k8s.Object) (bool, error) {
+		if obj == nil {
+			return false, errors.Errorf("deployment %q not found", name)
+		}
+		d, ok := obj.(*k8s.Deployment)
+		if !ok {
+			return false, errors.Errorf("expected a Deployment, got %T", obj)
+		}
+
+		s := DeploymentStatus(d)
+		if progress != nil && (last == nil || *last != *s) {
+			progress(s)
+		}
+		last = s
+
+		if s.Failed {
+			return false, errors.Wrapf(ErrProgressDeadlineExceeded, "deployment %q rollout failed", name)
+		}
+		return s.Complete, nil
+	}
+
+	_, err := k8s.WaitFor(ctx, k8s.DeploymentListWatcher(c, namespace, name), condition)
+	return last, err
+}
+
+func getCondition(status *k8s.DeploymentStatus, t k8s.DeploymentConditionType) *k8s.DeploymentCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == t {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
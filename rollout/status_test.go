@@ -0,0 +1,136 @@
+package rollout
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	k8s "github.com/bakins/k8s-client"
+	"github.com/bakins/k8s-client/fake"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeploymentStatus(t *testing.T) {
+	d := k8s.NewDeployment("default", "web")
+	d.Generation = 2
+	d.Spec.Replicas = 3
+	d.Status = &k8s.DeploymentStatus{ObservedGeneration: 1}
+
+	s := DeploymentStatus(d)
+	assert.False(t, s.Complete, "new generation has not been observed")
+
+	d.Status = &k8s.DeploymentStatus{
+		ObservedGeneration: 2,
+		Replicas:           4,
+		UpdatedReplicas:    2,
+		AvailableReplicas:  3,
+	}
+	s = DeploymentStatus(d)
+	assert.False(t, s.Complete, "not all replicas are updated")
+	assert.Equal(t, 2, s.UpdatedReplicas)
+
+	d.Status.UpdatedReplicas = 3
+	s = DeploymentStatus(d)
+	assert.False(t, s.Complete, "old replicas are pending termination")
+
+	d.Status.Replicas = 3
+	d.Status.AvailableReplicas = 3
+	s = DeploymentStatus(d)
+	assert.True(t, s.Complete)
+	assert.False(t, s.Failed)
+
+	d.Status.Conditions = []k8s.DeploymentCondition{
+		{
+			Type:   k8s.DeploymentProgressing,
+			Status: k8s.ConditionFalse,
+			Reason: k8s.TimedOutReason,
+		},
+	}
+	s = DeploymentStatus(d)
+	assert.True(t, s.Failed)
+	assert.False(t, s.Complete)
+}
+
+// waitForRollout runs WaitForRollout on the Deployment, sending each status
+// to the fake once the wait has seen the previous one.
+func waitForRollout(t *testing.T, statuses ...k8s.DeploymentStatus) ([]Status, *Status, error) {
+	c, err := fake.New()
+	require.Nil(t, err)
+	d := k8s.NewDeployment("default", "web")
+	d.Spec.Replicas = 3
+	d, err = c.CreateDeployment("default", d)
+	require.Nil(t, err)
+
+	var (
+		mu   sync.Mutex
+		seen []Status
+	)
+	progressed := make(chan struct{}, len(statuses)+1)
+	progress := func(s *Status) {
+		mu.Lock()
+		seen = append(seen, *s)
+		mu.Unlock()
+		progressed <- struct{}{}
+	}
+
+	go func() {
+		for i := range statuses {
+			<-progressed
+			got, err := c.GetDeployment("default", "web")
+			if err != nil {
+				return
+			}
+			got.Status = &statuses[i]
+			got.Status.ObservedGeneration = got.Generation
+			if _, err := c.UpdateDeploymentStatus("default", got); err != nil {
+				return
+			}
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	last, err := WaitForRollout(ctx, c, "default", "web", progress)
+
+	mu.Lock()
+	defer mu.Unlock()
+	return seen, last, err
+}
+
+func TestWaitForRollout(t *testing.T) {
+	seen, last, err := waitForRollout(t,
+		k8s.DeploymentStatus{Replicas: 4, UpdatedReplicas: 1, AvailableReplicas: 3},
+		k8s.DeploymentStatus{Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+	)
+	require.Nil(t, err)
+	require.NotNil(t, last)
+	assert.True(t, last.Complete)
+
+	require.Len(t, seen, 3)
+	assert.False(t, seen[0].Complete, "the new generation has not been observed")
+	assert.False(t, seen[1].Complete)
+	assert.Equal(t, 1, seen[1].UpdatedReplicas)
+	assert.True(t, seen[2].Complete)
+}
+
+func TestWaitForRolloutProgressDeadlineExceeded(t *testing.T) {
+	seen, last, err := waitForRollout(t,
+		k8s.DeploymentStatus{Replicas: 4, UpdatedReplicas: 1, AvailableReplicas: 3},
+		k8s.DeploymentStatus{
+			Replicas:          4,
+			UpdatedReplicas:   1,
+			AvailableReplicas: 3,
+			Conditions: []k8s.DeploymentCondition{
+				{Type: k8s.DeploymentProgressing, Status: k8s.ConditionFalse, Reason: k8s.TimedOutReason},
+			},
+		},
+	)
+	require.NotNil(t, err)
+	assert.Equal(t, ErrProgressDeadlineExceeded, errors.Cause(err))
+	require.NotNil(t, last)
+	assert.True(t, last.Failed)
+	assert.Len(t, seen, 3)
+}
@@ -1,21 +1,6 @@
 package client
 
 type (
-	// SecretInterface has methods to work with Secret resources.
-	SecretInterface interface {
-		CreateSecret(namespace string, item *Secret) (*Secret, error)
-		GetSecret(namespace, name string) (result *Secret, err error)
-		ListSecrets(namespace string, opts *ListOptions) (*SecretList, error)
-		WatchSecrets(namespace string, opts *WatchOptions, events chan SecretWatchEvent) error
-		DeleteSecret(namespace, name string) error
-		UpdateSecret(namespace string, item *Secret) (*Secret, error)
-	}
-
-	SecretWatchEvent interface {
-		Type() WatchEventType
-		Object() (*Secret, error)
-	}
-
 	// SecretType is the type of secret.
 	SecretType string
 
@@ -27,6 +12,9 @@ type (
 
 		// Used to facilitate programmatic handling of secret data.
 		Type SecretType `json:"type,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// SecretList holds a list of secrets.
@@ -46,3 +34,17 @@ func NewSecret(namespace, name string) *Secret {
 		Data:       make(map[string][]byte),
 	}
 }
+
+// UnmarshalJSON decodes the Secret, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	type alias Secret
+	return unmarshalKeepingUnknown(data, (*alias)(s), &s.raw)
+}
+
+// MarshalJSON encodes the Secret, including any fields it was decoded with
+// that this package does not model.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	type alias Secret
+	return marshalKeepingUnknown(alias(s), s.raw)
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"sort"
+	"strings"
+)
+
+// Values of LabelSelectorOperator
+const (
+	LabelSelectorOpIn           LabelSelectorOperator = "In"
+	LabelSelectorOpNotIn        LabelSelectorOperator = "NotIn"
+	LabelSelectorOpExists       LabelSelectorOperator = "Exists"
+	LabelSelectorOpDoesNotExist LabelSelectorOperator = "DoesNotExist"
+)
+
+// Matches reports whether the labels satisfy the selector. As in
+// Kubernetes, a nil selector matches nothing and an empty one matches
+// everything.
+func (s *LabelSelector) Matches(labels map[string]string) bool {
+	if s == nil {
+		return false
+	}
+	for k, v := range s.MatchLabels {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	for _, r := range s.MatchExpressions {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches reports whether the labels satisfy the requirement. Requirements
+// with an unknown operator match nothing.
+func (r *LabelSelectorRequirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+	switch r.Operator {
+	case LabelSelectorOpIn:
+		return ok && contains(r.Values, value)
+	case LabelSelectorOpNotIn:
+		return !ok || !contains(r.Values, value)
+	case LabelSelectorOpExists:
+		return ok
+	case LabelSelectorOpDoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+// String returns the selector in the syntax of the labelSelector query
+// parameter, such as "app=web,tier in (backend,cache),!canary".
+func (s *LabelSelector) String() string {
+	if s == nil {
+		return ""
+	}
+	var parts []string
+	for k, v := range s.MatchLabels {
+		parts = append(parts, k+"="+v)
+	}
+	sort.Strings(parts)
+	for _, r := range s.MatchExpressions {
+		switch r.Operator {
+		case LabelSelectorOpIn:
+			parts = append(parts, r.Key+" in ("+strings.Join(r.Values, ",")+")")
+		case LabelSelectorOpNotIn:
+			parts = append(parts, r.Key+" notin ("+strings.Join(r.Values, ",")+")")
+		case LabelSelectorOpExists:
+			parts = append(parts, r.Key)
+		case LabelSelectorOpDoesNotExist:
+			parts = append(parts, "!"+r.Key)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
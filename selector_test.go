@@ -0,0 +1,35 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelSelector(t *testing.T) {
+	s := &LabelSelector{
+		MatchLabels: map[string]string{"app": "web", "env": "prod"},
+		MatchExpressions: []LabelSelectorRequirement{
+			{Key: "tier", Operator: LabelSelectorOpIn, Values: []string{"backend", "cache"}},
+			{Key: "track", Operator: LabelSelectorOpNotIn, Values: []string{"canary"}},
+			{Key: "team", Operator: LabelSelectorOpExists},
+			{Key: "legacy", Operator: LabelSelectorOpDoesNotExist},
+		},
+	}
+	assert.Equal(t, "app=web,env=prod,tier in (backend,cache),track notin (canary),team,!legacy", s.String())
+
+	labels := map[string]string{"app": "web", "env": "prod", "tier": "cache", "team": "a"}
+	assert.True(t, s.Matches(labels))
+
+	labels["track"] = "canary"
+	assert.False(t, s.Matches(labels))
+	delete(labels, "track")
+
+	labels["legacy"] = "true"
+	assert.False(t, s.Matches(labels))
+
+	assert.True(t, (&LabelSelector{}).Matches(nil))
+	var none *LabelSelector
+	assert.False(t, none.Matches(labels))
+	assert.Equal(t, "", none.String())
+}
@@ -27,21 +27,6 @@ const (
 )
 
 type (
-	// ServiceInterface has methods to work with Service resources.
-	ServiceInterface interface {
-		CreateService(namespace string, item *Service) (*Service, error)
-		GetService(namespace, name string) (result *Service, err error)
-		ListServices(namespace string, opts *ListOptions) (*ServiceList, error)
-		WatchServices(namespace string, opts *WatchOptions, events chan ServiceWatchEvent) error
-		DeleteService(namespace, name string) error
-		UpdateService(namespace string, item *Service) (*Service, error)
-	}
-
-	ServiceWatchEvent interface {
-		Type() WatchEventType
-		Object() (*Service, error)
-	}
-
 	// Service is a named abstraction of software service (for example, mysql) consisting of local port (for example 3306) that the proxy listens on, and the selector that determines which pods will answer requests sent through the proxy.
 	Service struct {
 		TypeMeta   `json:",inline"`
@@ -52,6 +37,9 @@ type (
 
 		// Status represents the current status of a service.
 		Status *ServiceStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// ServiceList holds a list of services.
@@ -175,3 +163,17 @@ func NewService(namespace, name string) *Service {
 		},
 	}
 }
+
+// UnmarshalJSON decodes the Service, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (s *Service) UnmarshalJSON(data []byte) error {
+	type alias Service
+	return unmarshalKeepingUnknown(data, (*alias)(s), &s.raw)
+}
+
+// MarshalJSON encodes the Service, including any fields it was decoded with
+// that this package does not model.
+func (s Service) MarshalJSON() ([]byte, error) {
+	type alias Service
+	return marshalKeepingUnknown(alias(s), s.raw)
+}
@@ -1,21 +1,6 @@
 package client
 
 type (
-	// ServiceAccountInterface is the interface that defines ServiceAccount functions.
-	ServiceAccountInterface interface {
-		CreateServiceAccount(namespace string, item *ServiceAccount) (*ServiceAccount, error)
-		GetServiceAccount(namespace, name string) (result *ServiceAccount, err error)
-		ListServiceAccounts(namespace string, opts *ListOptions) (*ServiceAccountList, error)
-		WatchServiceAccounts(namespace string, opts *WatchOptions, events chan ServiceAccountWatchEvent) error
-		DeleteServiceAccount(namepsace, name string) error
-		UpdateServiceAccount(namespace string, item *ServiceAccount) (*ServiceAccount, error)
-	}
-
-	ServiceAccountWatchEvent interface {
-		Type() WatchEventType
-		Object() (*ServiceAccount, error)
-	}
-
 	// ServiceAccount binds together: * a name, understood by users, and perhaps by peripheral systems, for an identity * a principal that can be authenticated and authorized * a set of secrets
 	ServiceAccount struct {
 		TypeMeta   `json:",inline"`
@@ -28,6 +13,9 @@ type (
 		// in pods that reference this ServiceAccount.  ImagePullSecrets are distinct from Secrets because Secrets
 		// can be mounted in the pod, but ImagePullSecrets are only accessed by the kubelet.
 		ImagePullSecrets []LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
 	}
 
 	// ServiceAccountList is a list of ServiceAccount objects
@@ -45,3 +33,17 @@ func NewServiceAccount(namespace, name string) *ServiceAccount {
 		ObjectMeta: NewObjectMeta(namespace, name),
 	}
 }
+
+// UnmarshalJSON decodes the ServiceAccount, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (s *ServiceAccount) UnmarshalJSON(data []byte) error {
+	type alias ServiceAccount
+	return unmarshalKeepingUnknown(data, (*alias)(s), &s.raw)
+}
+
+// MarshalJSON encodes the ServiceAccount, including any fields it was decoded with
+// that this package does not model.
+func (s ServiceAccount) MarshalJSON() ([]byte, error) {
+	type alias ServiceAccount
+	return marshalKeepingUnknown(alias(s), s.raw)
+}
@@ -0,0 +1,184 @@
+package client
+
+const (
+	// OrderedReadyPodManagement creates pods in order from 0 to N-1, waiting
+	// for each to be Running and Ready before creating the next, and deletes
+	// them in reverse order. This is the default.
+	OrderedReadyPodManagement PodManagementPolicyType = "OrderedReady"
+	// ParallelPodManagement creates and deletes pods without waiting for
+	// other pods to be Running and Ready.
+	ParallelPodManagement PodManagementPolicyType = "Parallel"
+
+	// RollingUpdateStatefulSetStrategyType replaces pods one at a time, in
+	// reverse ordinal order, when the pod template changes. This is the default.
+	RollingUpdateStatefulSetStrategyType StatefulSetUpdateStrategyType = "RollingUpdate"
+	// OnDeleteStatefulSetStrategyType only replaces pods with the new
+	// template once they are deleted by hand.
+	OnDeleteStatefulSetStrategyType StatefulSetUpdateStrategyType = "OnDelete"
+
+	// StatefulSetRevisionLabel is set on each pod of a StatefulSet to the
+	// name of the revision it was created from.
+	StatefulSetRevisionLabel = "controller-revision-hash"
+	// StatefulSetPodNameLabel is set on each pod of a StatefulSet to the pod name.
+	StatefulSetPodNameLabel = "statefulset.kubernetes.io/pod-name"
+)
+
+type (
+	// StatefulSet represents a set of pods with consistent identities and
+	// stable storage.
+	StatefulSet struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec defines the desired identities of pods in this set.
+		Spec *StatefulSetSpec `json:"spec,omitempty"`
+
+		// Status is the current status of pods in this StatefulSet. This data
+		// may be out of date by some window of time.
+		Status *StatefulSetStatus `json:"status,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// StatefulSetSpec is the specification of a StatefulSet.
+	StatefulSetSpec struct {
+		// Replicas is the desired number of replicas of the given Template.
+		Replicas int32 `json:"replicas"`
+
+		// Selector is a label query over pods that should match the replica count.
+		// It must match the pod template's labels.
+		Selector *LabelSelector `json:"selector,omitempty"`
+
+		// Template is the object that describes the pod that will be created if
+		// insufficient replicas are detected. Each pod stamped out by the
+		// StatefulSet will fulfill this Template, but have a unique identity
+		// from the rest of the StatefulSet.
+		Template PodTemplateSpec `json:"template"`
+
+		// VolumeClaimTemplates is a list of claims that pods are allowed to
+		// reference. Every claim in this list must have at least one matching
+		// (by name) volumeMount in one container in the template.
+		VolumeClaimTemplates []PersistentVolumeClaim `json:"volumeClaimTemplates,omitempty"`
+
+		// ServiceName is the name of the service that governs this StatefulSet.
+		// This service must exist before the StatefulSet, and is responsible
+		// for the network identity of the set.
+		ServiceName string `json:"serviceName"`
+
+		// PodManagementPolicy controls how pods are created during initial
+		// scale up, when replacing pods on nodes, or when scaling down.
+		PodManagementPolicy PodManagementPolicyType `json:"podManagementPolicy,omitempty"`
+
+		// UpdateStrategy indicates the StatefulSetUpdateStrategy that will be
+		// employed to update pods in the StatefulSet when a revision is made to
+		// Template.
+		UpdateStrategy StatefulSetUpdateStrategy `json:"updateStrategy,omitempty"`
+
+		// RevisionHistoryLimit is the maximum number of revisions that will be
+		// maintained in the StatefulSet's revision history.
+		RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+	}
+
+	// StatefulSetUpdateStrategy indicates the strategy that the StatefulSet
+	// controller will use to perform updates.
+	StatefulSetUpdateStrategy struct {
+		// Type indicates the type of the StatefulSetUpdateStrategy.
+		// Default is RollingUpdate.
+		Type StatefulSetUpdateStrategyType `json:"type,omitempty"`
+
+		// RollingUpdate is used to communicate parameters when Type is
+		// RollingUpdateStatefulSetStrategyType.
+		RollingUpdate *RollingUpdateStatefulSetStrategy `json:"rollingUpdate,omitempty"`
+	}
+
+	// RollingUpdateStatefulSetStrategy is used to communicate parameter for
+	// RollingUpdateStatefulSetStrategyType.
+	RollingUpdateStatefulSetStrategy struct {
+		// Partition indicates the ordinal at which the StatefulSet should be
+		// partitioned. Pods with an ordinal greater than or equal to the
+		// partition are updated; the others keep the current revision.
+		// Default value is 0.
+		Partition *int32 `json:"partition,omitempty"`
+	}
+
+	// StatefulSetStatus represents the current state of a StatefulSet.
+	StatefulSetStatus struct {
+		// ObservedGeneration is the most recent generation observed for this
+		// StatefulSet. It corresponds to the StatefulSet's generation, which is
+		// updated on mutation by the API Server.
+		ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+		// Replicas is the number of pods created by the StatefulSet controller.
+		Replicas int32 `json:"replicas"`
+
+		// ReadyReplicas is the number of pods created by the StatefulSet
+		// controller that have a Ready condition.
+		ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+		// CurrentReplicas is the number of pods created by the StatefulSet
+		// controller from the StatefulSet version indicated by CurrentRevision.
+		CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+
+		// UpdatedReplicas is the number of pods created by the StatefulSet
+		// controller from the StatefulSet version indicated by UpdateRevision.
+		UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+		// CurrentRevision, if not empty, indicates the version of the
+		// StatefulSet used to generate pods in the sequence [0,currentReplicas).
+		CurrentRevision string `json:"currentRevision,omitempty"`
+
+		// UpdateRevision, if not empty, indicates the version of the
+		// StatefulSet used to generate pods in the sequence
+		// [replicas-updatedReplicas,replicas)
+		UpdateRevision string `json:"updateRevision,omitempty"`
+	}
+
+	// StatefulSetList is a collection of StatefulSets.
+	StatefulSetList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is the list of stateful sets.
+		Items []StatefulSet `json:"items"`
+	}
+
+	// PodManagementPolicyType defines the policy for creating pods under a StatefulSet.
+	PodManagementPolicyType string
+
+	// StatefulSetUpdateStrategyType is a string enumeration type that
+	// enumerates all possible update strategies for the StatefulSet controller.
+	StatefulSetUpdateStrategyType string
+)
+
+// NewStatefulSet creates a new StatefulSet struct
+func NewStatefulSet(namespace, name string) *StatefulSet {
+	return &StatefulSet{
+		TypeMeta:   NewTypeMeta("StatefulSet", "apps/v1"),
+		ObjectMeta: NewObjectMeta(namespace, name),
+		Spec:       &StatefulSetSpec{},
+	}
+}
+
+// Partition returns the partition of the rolling update strategy, which is
+// 0 if it is not set.
+func (s *StatefulSet) Partition() int32 {
+	if s.Spec == nil || s.Spec.UpdateStrategy.RollingUpdate == nil || s.Spec.UpdateStrategy.RollingUpdate.Partition == nil {
+		return 0
+	}
+	return *s.Spec.UpdateStrategy.RollingUpdate.Partition
+}
+
+// UnmarshalJSON decodes the StatefulSet, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (s *StatefulSet) UnmarshalJSON(data []byte) error {
+	type alias StatefulSet
+	return unmarshalKeepingUnknown(data, (*alias)(s), &s.raw)
+}
+
+// MarshalJSON encodes the StatefulSet, including any fields it was decoded with
+// that this package does not model.
+func (s StatefulSet) MarshalJSON() ([]byte, error) {
+	type alias StatefulSet
+	return marshalKeepingUnknown(alias(s), s.raw)
+}
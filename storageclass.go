@@ -0,0 +1,88 @@
+package client
+
+const (
+	// VolumeBindingImmediate binds and provisions a PersistentVolumeClaim as
+	// soon as it is created.
+	VolumeBindingImmediate VolumeBindingMode = "Immediate"
+	// VolumeBindingWaitForFirstConsumer delays binding and provisioning of a
+	// PersistentVolumeClaim until a pod using it is scheduled.
+	VolumeBindingWaitForFirstConsumer VolumeBindingMode = "WaitForFirstConsumer"
+
+	// IsDefaultStorageClassAnnotation marks the StorageClass used for claims
+	// that do not request one.
+	IsDefaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
+type (
+	// StorageClass describes the parameters for a class of storage for which
+	// PersistentVolumes can be dynamically provisioned.
+	StorageClass struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Provisioner indicates the type of the provisioner.
+		Provisioner string `json:"provisioner"`
+
+		// Parameters holds the parameters for the provisioner that should
+		// create volumes of this storage class.
+		Parameters map[string]string `json:"parameters,omitempty"`
+
+		// Dynamically provisioned PersistentVolumes of this storage class are
+		// created with this reclaimPolicy. Defaults to Delete.
+		ReclaimPolicy *PersistentVolumeReclaimPolicy `json:"reclaimPolicy,omitempty"`
+
+		// Dynamically provisioned PersistentVolumes of this storage class are
+		// created with these mountOptions, e.g. ["ro", "soft"].
+		MountOptions []string `json:"mountOptions,omitempty"`
+
+		// AllowVolumeExpansion shows whether the storage class allow volume expand
+		AllowVolumeExpansion *bool `json:"allowVolumeExpansion,omitempty"`
+
+		// VolumeBindingMode indicates how PersistentVolumeClaims should be
+		// provisioned and bound. When unset, VolumeBindingImmediate is used.
+		VolumeBindingMode *VolumeBindingMode `json:"volumeBindingMode,omitempty"`
+
+		// raw is the JSON the object was decoded from.
+		raw unknownFields
+	}
+
+	// StorageClassList is a collection of storage classes.
+	StorageClassList struct {
+		TypeMeta `json:",inline"`
+		ListMeta `json:"metadata,omitempty"`
+
+		// Items is the list of StorageClasses
+		Items []StorageClass `json:"items"`
+	}
+
+	// VolumeBindingMode indicates how PersistentVolumeClaims should be bound.
+	VolumeBindingMode string
+)
+
+// NewStorageClass creates a new StorageClass struct
+func NewStorageClass(name, provisioner string) *StorageClass {
+	return &StorageClass{
+		TypeMeta:    NewTypeMeta("StorageClass", "storage.k8s.io/v1"),
+		ObjectMeta:  NewObjectMeta("", name),
+		Provisioner: provisioner,
+	}
+}
+
+// IsDefault reports whether the StorageClass is marked as the default.
+func (s *StorageClass) IsDefault() bool {
+	return s.Annotations[IsDefaultStorageClassAnnotation] == "true"
+}
+
+// UnmarshalJSON decodes the StorageClass, keeping the fields this package does not
+// model so that they are sent back by MarshalJSON.
+func (s *StorageClass) UnmarshalJSON(data []byte) error {
+	type alias StorageClass
+	return unmarshalKeepingUnknown(data, (*alias)(s), &s.raw)
+}
+
+// MarshalJSON encodes the StorageClass, including any fields it was decoded with
+// that this package does not model.
+func (s StorageClass) MarshalJSON() ([]byte, error) {
+	type alias StorageClass
+	return marshalKeepingUnknown(alias(s), s.raw)
+}
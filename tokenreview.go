@@ -0,0 +1,72 @@
+package client
+
+type (
+	// TokenReviewInterface authenticates bearer tokens using the
+	// authenticators of the API server.
+	TokenReviewInterface interface {
+		CreateTokenReview(item *TokenReview) (*TokenReview, error)
+	}
+
+	// TokenReview attempts to authenticate a token to a known user.
+	TokenReview struct {
+		TypeMeta   `json:",inline"`
+		ObjectMeta `json:"metadata,omitempty"`
+
+		// Spec holds information about the request being evaluated
+		Spec TokenReviewSpec `json:"spec"`
+
+		// Status is filled in by the server and indicates whether the request
+		// can be authenticated.
+		Status *TokenReviewStatus `json:"status,omitempty"`
+	}
+
+	// TokenReviewSpec is a description of the token authentication request.
+	TokenReviewSpec struct {
+		// Token is the opaque bearer token.
+		Token string `json:"token,omitempty"`
+		// Audiences is a list of the identifiers that the resource server
+		// presented with the token identifies as. Audience-aware token
+		// authenticators will verify that the token was intended for at least
+		// one of the audiences in this list.
+		Audiences []string `json:"audiences,omitempty"`
+	}
+
+	// TokenReviewStatus is the result of the token authentication request.
+	TokenReviewStatus struct {
+		// Authenticated indicates that the token was associated with a known user.
+		Authenticated bool `json:"authenticated,omitempty"`
+		// User is the UserInfo associated with the provided token.
+		User UserInfo `json:"user,omitempty"`
+		// Audiences are audience identifiers chosen by the authenticator that
+		// are compatible with both the TokenReview and token.
+		Audiences []string `json:"audiences,omitempty"`
+		// Error indicates that the token couldn't be checked
+		Error string `json:"error,omitempty"`
+	}
+
+	// UserInfo holds the information about the user needed to implement the
+	// user.Info interface.
+	UserInfo struct {
+		// The name that uniquely identifies this user among all active users.
+		Username string `json:"username,omitempty"`
+		// A unique value that identifies this user across time. If this user
+		// is deleted and another user by the same name is added, they will
+		// have different UIDs.
+		UID string `json:"uid,omitempty"`
+		// The names of groups this user is a part of.
+		Groups []string `json:"groups,omitempty"`
+		// Any additional information provided by the authenticator.
+		Extra map[string][]string `json:"extra,omitempty"`
+	}
+)
+
+// NewTokenReview creates a new TokenReview struct
+func NewTokenReview(token string, audiences ...string) *TokenReview {
+	return &TokenReview{
+		TypeMeta: NewTypeMeta("TokenReview", "authentication.k8s.io/v1"),
+		Spec: TokenReviewSpec{
+			Token:     token,
+			Audiences: audiences,
+		},
+	}
+}
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// The types in this package model only part of the Kubernetes API. To avoid
+// an update erasing fields that the server knows about but we do not, each
+// kind keeps the JSON it was decoded from. When the object is encoded again,
+// any field in that JSON that is not part of the Go type is copied back into
+// the output at the same place. Fields that are part of the Go type always
+// take their value from the Go type, so clearing one still removes it.
+
+var marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// unknownFields holds the JSON an object was decoded from.
+type unknownFields []byte
+
+// unmarshalKeepingUnknown decodes data into v, which must be a pointer to an
+// alias of a kind without its own UnmarshalJSON, and remembers data in raw.
+func unmarshalKeepingUnknown(data []byte, v interface{}, raw *unknownFields) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*raw = nil
+		return nil
+	}
+	// the decoder may reuse data after we return
+	*raw = append(unknownFields(nil), data...)
+	return nil
+}
+
+// marshalKeepingUnknown encodes v, which must be an alias of a kind without
+// its own MarshalJSON, and adds back the fields from raw that v does not model.
+func marshalKeepingUnknown(v interface{}, raw unknownFields) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(raw) == 0 {
+		return data, err
+	}
+
+	typed, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	original, err := decodeJSON(raw)
+	if err != nil {
+		// should not happen as raw was decoded before, so keep what we have.
+		return data, nil
+	}
+	return json.Marshal(mergeUnknown(reflect.TypeOf(v), typed, original))
+}
+
+// decodeJSON decodes data keeping numbers exactly as they were.
+func decodeJSON(data []byte) (interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v interface{}
+	err := d.Decode(&v)
+	return v, err
+}
+
+// mergeUnknown returns typed, the encoding of a value of type t, with the
+// fields of original that t does not know about added back.
+func mergeUnknown(t reflect.Type, typed, original interface{}) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType) {
+		// types such as Time and Quantity encode themselves.
+		return typed
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		tm, ok := typed.(map[string]interface{})
+		om, ok2 := original.(map[string]interface{})
+		if !ok || !ok2 {
+			return typed
+		}
+		fields := jsonFields(t)
+		for k, ov := range om {
+			ft, known := fields[k]
+			if !known {
+				tm[k] = ov
+				continue
+			}
+			// a known field missing from typed was cleared, so leave it out.
+			if tv, ok := tm[k]; ok {
+				tm[k] = mergeUnknown(ft, tv, ov)
+			}
+		}
+		return tm
+
+	case reflect.Map:
+		tm, ok := typed.(map[string]interface{})
+		om, ok2 := original.(map[string]interface{})
+		if !ok || !ok2 {
+			return typed
+		}
+		for k, tv := range tm {
+			if ov, ok := om[k]; ok {
+				tm[k] = mergeUnknown(t.Elem(), tv, ov)
+			}
+		}
+		return tm
+
+	case reflect.Slice, reflect.Array:
+		ts, ok := typed.([]interface{})
+		ol, ok2 := original.([]interface{})
+		if !ok || !ok2 {
+			return typed
+		}
+		if tn, on := itemNames(ts), itemNames(ol); tn != nil && on != nil {
+			// items such as containers and ports are matched by name
+			byName := make(map[string]interface{}, len(ol))
+			for i, name := range on {
+				byName[name] = ol[i]
+			}
+			for i, tv := range ts {
+				if ov, ok := byName[tn[i]]; ok {
+					ts[i] = mergeUnknown(t.Elem(), tv, ov)
+				}
+			}
+			return ts
+		}
+		if len(ts) == len(ol) {
+			for i := range ts {
+				ts[i] = mergeUnknown(t.Elem(), ts[i], ol[i])
+			}
+		}
+		return ts
+	}
+	return typed
+}
+
+// itemNames returns the name of each item if all items are objects with a
+// unique name, and nil otherwise.
+func itemNames(items []interface{}) []string {
+	if len(items) == 0 {
+		return nil
+	}
+	names := make([]string, len(items))
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		name, ok := m["name"].(string)
+		if !ok || seen[name] {
+			return nil
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+// jsonFields returns the type of each field of the struct t by JSON name,
+// including the fields of embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := tag
+		if i := strings.Index(tag, ","); i >= 0 {
+			name = tag[:i]
+		}
+		if name == "" && f.Anonymous {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	// fields of the outer struct take precedence
+	for _, et := range embedded {
+		for name, ft := range jsonFields(et) {
+			if _, ok := fields[name]; !ok {
+				fields[name] = ft
+			}
+		}
+	}
+	return fields
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownFieldsRoundTrip(t *testing.T) {
+	in := `{
+		"kind": "Pod",
+		"apiVersion": "v1",
+		"metadata": {"name": "web", "labels": {"app": "web", "tier": "front"}},
+		"spec": {
+			"priority": 1000000000000000001,
+			"tolerations": [{"key": "dedicated", "operator": "Exists"}],
+			"initContainers": [{"name": "init", "image": "busybox"}],
+			"containers": [
+				{"name": "web", "image": "nginx:1.0", "stdinOnce": true},
+				{"name": "sidecar", "image": "envoy", "tty": true}
+			]
+		}
+	}`
+
+	var pod Pod
+	require.Nil(t, json.Unmarshal([]byte(in), &pod))
+
+	pod.Spec.Containers[0].Image = "nginx:2.0"
+	// swap the containers to check they are matched by name
+	pod.Spec.Containers[0], pod.Spec.Containers[1] = pod.Spec.Containers[1], pod.Spec.Containers[0]
+	delete(pod.Labels, "tier")
+
+	out, err := json.Marshal(&pod)
+	require.Nil(t, err)
+
+	expected := `{
+		"kind": "Pod",
+		"apiVersion": "v1",
+		"metadata": {"name": "web", "labels": {"app": "web"}},
+		"spec": {
+			"priority": 1000000000000000001,
+			"tolerations": [{"key": "dedicated", "operator": "Exists"}],
+			"initContainers": [{"name": "init", "image": "busybox"}],
+			"containers": [
+				{"name": "sidecar", "image": "envoy", "tty": true},
+				{"name": "web", "image": "nginx:2.0", "stdinOnce": true}
+			]
+		}
+	}`
+	assert.JSONEq(t, expected, string(out))
+	assert.Contains(t, string(out), `"priority":1000000000000000001`)
+
+	// values are encoded the same way
+	out, err = json.Marshal(pod)
+	require.Nil(t, err)
+	assert.JSONEq(t, expected, string(out))
+}
+
+func TestUnknownFieldsClearedKnownField(t *testing.T) {
+	var d Deployment
+	require.Nil(t, json.Unmarshal([]byte(`{"metadata":{"name":"web","annotations":{"a":"b"}},"spec":{"paused":true,"revisionHistoryLimit":3}}`), &d))
+
+	d.Annotations = nil
+	d.Spec.Paused = false
+
+	out, err := json.Marshal(d)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"metadata":{"name":"web"},"spec":{"revisionHistoryLimit":3,"template":{"metadata":{}}}}`, string(out))
+}
+
+func TestUnknownFieldsNew(t *testing.T) {
+	out, err := json.Marshal(NewConfigMap("default", "test"))
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"test","namespace":"default"}}`, string(out))
+}
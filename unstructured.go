@@ -0,0 +1,567 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Values of PatchType
+const (
+	JSONPatchType           PatchType = "application/json-patch+json"
+	MergePatchType          PatchType = "application/merge-patch+json"
+	StrategicMergePatchType PatchType = "application/strategic-merge-patch+json"
+)
+
+type (
+	// PatchType is the content type of a patch.
+	PatchType string
+
+	// GroupVersionResource identifies a resource, such as "deployments" in
+	// the "apps" group at version "v1". The core group is "".
+	GroupVersionResource struct {
+		Group    string
+		Version  string
+		Resource string
+	}
+
+	// DynamicInterface gives access to resources that may not be modelled
+	// by this package.
+	DynamicInterface interface {
+		Resource(resource GroupVersionResource) DynamicResourceInterface
+	}
+
+	// DynamicResourceInterface manipulates a single resource as Unstructured
+	// objects. The scope of the resource is found with discovery, and
+	// namespace is ignored for cluster scoped resources. It may be "" to
+	// list or watch across all namespaces. Watch closes events when the
+	// watch ends.
+	DynamicResourceInterface interface {
+		Get(namespace, name string) (*Unstructured, error)
+		List(namespace string, opts *ListOptions) (*UnstructuredList, error)
+		Create(namespace string, item *Unstructured) (*Unstructured, error)
+		Update(namespace string, item *Unstructured) (*Unstructured, error)
+		Delete(namespace, name string) error
+		Watch(namespace string, opts *WatchOptions, events chan UnstructuredWatchEvent) error
+		Patch(namespace, name string, pt PatchType, data []byte) (*Unstructured, error)
+	}
+
+	UnstructuredWatchEvent interface {
+		Type() WatchEventType
+		Object() (*Unstructured, error)
+	}
+
+	// Unstructured is an object of any kind held as decoded JSON. Numbers are
+	// held as int64 when they are whole and float64 otherwise.
+	Unstructured struct {
+		Object map[string]interface{}
+	}
+
+	// UnstructuredList is a list of objects of any kind.
+	UnstructuredList struct {
+		// Object holds the fields of the list other than items.
+		Object map[string]interface{}
+		Items  []Unstructured
+	}
+)
+
+// GroupVersion returns the apiVersion of the resource, such as "apps/v1" or "v1".
+func (gvr GroupVersionResource) GroupVersion() string {
+	if gvr.Group == "" {
+		return gvr.Version
+	}
+	return gvr.Group + "/" + gvr.Version
+}
+
+func (gvr GroupVersionResource) String() string {
+	return gvr.GroupVersion() + ", Resource=" + gvr.Resource
+}
+
+// NewUnstructured creates a new Unstructured with the given apiVersion, kind,
+// namespace and name.
+func NewUnstructured(apiVersion, kind, namespace, name string) *Unstructured {
+	u := &Unstructured{Object: make(map[string]interface{})}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	return u
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface.
+func (u *Unstructured) UnmarshalJSON(data []byte) error {
+	obj, err := decodeObject(data)
+	if err != nil {
+		return err
+	}
+	u.Object = obj
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface.
+func (u Unstructured) MarshalJSON() ([]byte, error) {
+	if u.Object == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(u.Object)
+}
+
+// DeepCopy returns a copy of u that does not share any state.
+func (u *Unstructured) DeepCopy() *Unstructured {
+	if u == nil {
+		return nil
+	}
+	out, _ := deepCopyJSON(u.Object).(map[string]interface{})
+	return &Unstructured{Object: out}
+}
+
+func (u *Unstructured) getString(fields ...string) string {
+	s, _, _ := NestedString(u.Object, fields...)
+	return s
+}
+
+func (u *Unstructured) setField(value interface{}, fields ...string) {
+	if u.Object == nil {
+		u.Object = make(map[string]interface{})
+	}
+	// only fails if the metadata is not a map
+	_ = SetNestedField(u.Object, value, fields...)
+}
+
+// setOrRemove sets the field or removes it when the value is empty, in the
+// same way as omitempty does for the typed objects.
+func (u *Unstructured) setOrRemove(empty bool, value interface{}, fields ...string) {
+	if empty {
+		RemoveNestedField(u.Object, fields...)
+		return
+	}
+	u.setField(value, fields...)
+}
+
+func (u *Unstructured) GetKind() string {
+	return u.getString("kind")
+}
+
+func (u *Unstructured) SetKind(kind string) {
+	u.setOrRemove(kind == "", kind, "kind")
+}
+
+func (u *Unstructured) GetAPIVersion() string {
+	return u.getString("apiVersion")
+}
+
+func (u *Unstructured) SetAPIVersion(version string) {
+	u.setOrRemove(version == "", version, "apiVersion")
+}
+
+func (u *Unstructured) GetName() string {
+	return u.getString("metadata", "name")
+}
+
+func (u *Unstructured) SetName(name string) {
+	u.setOrRemove(name == "", name, "metadata", "name")
+}
+
+func (u *Unstructured) GetNamespace() string {
+	return u.getString("metadata", "namespace")
+}
+
+func (u *Unstructured) SetNamespace(namespace string) {
+	u.setOrRemove(namespace == "", namespace, "metadata", "namespace")
+}
+
+func (u *Unstructured) GetUID() UID {
+	return UID(u.getString("metadata", "uid"))
+}
+
+func (u *Unstructured) GetResourceVersion() string {
+	return u.getString("metadata", "resourceVersion")
+}
+
+func (u *Unstructured) SetResourceVersion(version string) {
+	u.setOrRemove(version == "", version, "metadata", "resourceVersion")
+}
+
+func (u *Unstructured) GetAnnotations() map[string]string {
+	m, _, _ := NestedStringMap(u.Object, "metadata", "annotations")
+	return m
+}
+
+func (u *Unstructured) SetAnnotations(annotations map[string]string) {
+	u.setOrRemove(len(annotations) == 0, stringMap(annotations), "metadata", "annotations")
+}
+
+func (u *Unstructured) GetLabels() map[string]string {
+	m, _, _ := NestedStringMap(u.Object, "metadata", "labels")
+	return m
+}
+
+func (u *Unstructured) SetLabels(labels map[string]string) {
+	u.setOrRemove(len(labels) == 0, stringMap(labels), "metadata", "labels")
+}
+
+func (u *Unstructured) GetFinalizers() []string {
+	s, _, _ := NestedStringSlice(u.Object, "metadata", "finalizers")
+	return s
+}
+
+func (u *Unstructured) SetFinalizers(finalizers []string) {
+	value := make([]interface{}, len(finalizers))
+	for i, f := range finalizers {
+		value[i] = f
+	}
+	u.setOrRemove(len(finalizers) == 0, value, "metadata", "finalizers")
+}
+
+func (u *Unstructured) GetOwnerReferences() []OwnerReference {
+	field, ok, _ := NestedFieldNoCopy(u.Object, "metadata", "ownerReferences")
+	if !ok {
+		return nil
+	}
+	var refs []OwnerReference
+	if err := convertJSON(field, &refs); err != nil {
+		return nil
+	}
+	return refs
+}
+
+func (u *Unstructured) SetOwnerReferences(references []OwnerReference) {
+	if len(references) == 0 {
+		RemoveNestedField(u.Object, "metadata", "ownerReferences")
+		return
+	}
+	u.setField(references, "metadata", "ownerReferences")
+}
+
+// GetResourceVersion returns the resource version of the list.
+func (l *UnstructuredList) GetResourceVersion() string {
+	s, _, _ := NestedString(l.Object, "metadata", "resourceVersion")
+	return s
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface.
+func (l *UnstructuredList) UnmarshalJSON(data []byte) error {
+	obj, err := decodeObject(data)
+	if err != nil {
+		return err
+	}
+	items, _ := obj["items"].([]interface{})
+	delete(obj, "items")
+
+	l.Object = obj
+	l.Items = make([]Unstructured, 0, len(items))
+	for i, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("item %d of list is %T, not an object", i, item)
+		}
+		l.Items = append(l.Items, Unstructured{Object: m})
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface.
+func (l UnstructuredList) MarshalJSON() ([]byte, error) {
+	obj := make(map[string]interface{}, len(l.Object)+1)
+	for k, v := range l.Object {
+		obj[k] = v
+	}
+	items := make([]interface{}, len(l.Items))
+	for i := range l.Items {
+		items[i] = l.Items[i].Object
+	}
+	obj["items"] = items
+	return json.Marshal(obj)
+}
+
+// NestedFieldNoCopy returns the value of the nested field and whether it was
+// found. The value is not copied, so changes to it are made to obj.
+func NestedFieldNoCopy(obj map[string]interface{}, fields ...string) (interface{}, bool, error) {
+	var value interface{} = obj
+	for i, field := range fields {
+		if value == nil {
+			return nil, false, nil
+		}
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false, errors.Errorf("%v accessor error: %v is of the type %T, expected map[string]interface{}",
+				jsonPath(fields[:i+1]), value, value)
+		}
+		value, ok = m[field]
+		if !ok {
+			return nil, false, nil
+		}
+	}
+	return value, true, nil
+}
+
+// NestedString returns the string value of the nested field.
+func NestedString(obj map[string]interface{}, fields ...string) (string, bool, error) {
+	value, found, err := NestedFieldNoCopy(obj, fields...)
+	if !found || err != nil {
+		return "", found, err
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", false, errors.Errorf("%v accessor error: %v is of the type %T, expected string", jsonPath(fields), value, value)
+	}
+	return s, true, nil
+}
+
+// NestedBool returns the bool value of the nested field.
+func NestedBool(obj map[string]interface{}, fields ...string) (bool, bool, error) {
+	value, found, err := NestedFieldNoCopy(obj, fields...)
+	if !found || err != nil {
+		return false, found, err
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, false, errors.Errorf("%v accessor error: %v is of the type %T, expected bool", jsonPath(fields), value, value)
+	}
+	return b, true, nil
+}
+
+// NestedInt64 returns the int64 value of the nested field.
+func NestedInt64(obj map[string]interface{}, fields ...string) (int64, bool, error) {
+	value, found, err := NestedFieldNoCopy(obj, fields...)
+	if !found || err != nil {
+		return 0, found, err
+	}
+	switch n := value.(type) {
+	case int64:
+		return n, true, nil
+	case int:
+		return int64(n), true, nil
+	case int32:
+		return int64(n), true, nil
+	}
+	return 0, false, errors.Errorf("%v accessor error: %v is of the type %T, expected int64", jsonPath(fields), value, value)
+}
+
+// NestedFloat64 returns the float64 value of the nested field.
+func NestedFloat64(obj map[string]interface{}, fields ...string) (float64, bool, error) {
+	value, found, err := NestedFieldNoCopy(obj, fields...)
+	if !found || err != nil {
+		return 0, found, err
+	}
+	switch n := value.(type) {
+	case float64:
+		return n, true, nil
+	case int64:
+		return float64(n), true, nil
+	}
+	return 0, false, errors.Errorf("%v accessor error: %v is of the type %T, expected float64", jsonPath(fields), value, value)
+}
+
+// NestedStringSlice returns a copy of the []string value of the nested field.
+func NestedStringSlice(obj map[string]interface{}, fields ...string) ([]string, bool, error) {
+	value, found, err := NestedFieldNoCopy(obj, fields...)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	s, ok := value.([]interface{})
+	if !ok {
+		return nil, false, errors.Errorf("%v accessor error: %v is of the type %T, expected []interface{}", jsonPath(fields), value, value)
+	}
+	out := make([]string, 0, len(s))
+	for _, v := range s {
+		str, ok := v.(string)
+		if !ok {
+			return nil, false, errors.Errorf("%v accessor error: contains non-string value %v of the type %T", jsonPath(fields), v, v)
+		}
+		out = append(out, str)
+	}
+	return out, true, nil
+}
+
+// NestedSlice returns a deep copy of the []interface{} value of the nested field.
+func NestedSlice(obj map[string]interface{}, fields ...string) ([]interface{}, bool, error) {
+	value, found, err := NestedFieldNoCopy(obj, fields...)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	s, ok := value.([]interface{})
+	if !ok {
+		return nil, false, errors.Errorf("%v accessor error: %v is of the type %T, expected []interface{}", jsonPath(fields), value, value)
+	}
+	return deepCopyJSON(s).([]interface{}), true, nil
+}
+
+// NestedMap returns a deep copy of the map[string]interface{} value of the nested field.
+func NestedMap(obj map[string]interface{}, fields ...string) (map[string]interface{}, bool, error) {
+	value, found, err := NestedFieldNoCopy(obj, fields...)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false, errors.Errorf("%v accessor error: %v is of the type %T, expected map[string]interface{}", jsonPath(fields), value, value)
+	}
+	return deepCopyJSON(m).(map[string]interface{}), true, nil
+}
+
+// NestedStringMap returns a copy of the map[string]string value of the nested field.
+func NestedStringMap(obj map[string]interface{}, fields ...string) (map[string]string, bool, error) {
+	value, found, err := NestedFieldNoCopy(obj, fields...)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false, errors.Errorf("%v accessor error: %v is of the type %T, expected map[string]interface{}", jsonPath(fields), value, value)
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		str, ok := v.(string)
+		if !ok {
+			return nil, false, errors.Errorf("%v accessor error: contains non-string value %v of the type %T for key %s", jsonPath(fields), v, v, k)
+		}
+		out[k] = str
+	}
+	return out, true, nil
+}
+
+// SetNestedField sets a copy of value at the nested field, creating any
+// missing maps on the way. Values other than decoded JSON, such as a
+// map[string]string, are converted through JSON.
+func SetNestedField(obj map[string]interface{}, value interface{}, fields ...string) error {
+	if len(fields) == 0 {
+		return errors.New("no fields given")
+	}
+	m := obj
+	for i, field := range fields[:len(fields)-1] {
+		next, ok := m[field]
+		if !ok || next == nil {
+			child := make(map[string]interface{})
+			m[field] = child
+			m = child
+			continue
+		}
+		child, ok := next.(map[string]interface{})
+		if !ok {
+			return errors.Errorf("value cannot be set because %v is not a map[string]interface{}", jsonPath(fields[:i+1]))
+		}
+		m = child
+	}
+	v, err := toJSONValue(value)
+	if err != nil {
+		return errors.Wrapf(err, "value cannot be set at %v", jsonPath(fields))
+	}
+	m[fields[len(fields)-1]] = v
+	return nil
+}
+
+// RemoveNestedField removes the nested field, if it exists.
+func RemoveNestedField(obj map[string]interface{}, fields ...string) {
+	if len(fields) == 0 {
+		return
+	}
+	m := obj
+	for _, field := range fields[:len(fields)-1] {
+		child, ok := m[field].(map[string]interface{})
+		if !ok {
+			return
+		}
+		m = child
+	}
+	delete(m, fields[len(fields)-1])
+}
+
+func jsonPath(fields []string) string {
+	return "." + strings.Join(fields, ".")
+}
+
+func stringMap(m map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+// decodeObject decodes a JSON object, turning numbers into int64 or float64.
+func decodeObject(data []byte) (map[string]interface{}, error) {
+	value, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := convertNumbers(value).(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("expected a JSON object, got %s", bytes.TrimSpace(data))
+	}
+	return obj, nil
+}
+
+func convertNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = convertNumbers(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = convertNumbers(e)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	}
+	return value
+}
+
+// deepCopyJSON copies decoded JSON values.
+func deepCopyJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			out[k] = deepCopyJSON(e)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = deepCopyJSON(e)
+		}
+		return out
+	}
+	return value
+}
+
+// toJSONValue returns a copy of value made only of the types found in
+// decoded JSON. Other values, such as typed maps, are converted through JSON.
+func toJSONValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}, []interface{}, nil, string, bool, int64, float64:
+		return deepCopyJSON(v), nil
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	out, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return convertNumbers(out), nil
+}
+
+// convertJSON converts in to out by encoding and decoding it.
+func convertJSON(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
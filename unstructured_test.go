@@ -0,0 +1,84 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnstructuredAccessors(t *testing.T) {
+	var u Unstructured
+	err := json.Unmarshal([]byte(`{
+		"apiVersion": "apps/v1",
+		"kind": "StatefulSet",
+		"metadata": {"name": "db", "namespace": "default", "uid": "123", "labels": {"app": "db"}},
+		"spec": {"replicas": 3, "serviceName": "db", "paused": false, "ratio": 0.5}
+	}`), &u)
+	require.Nil(t, err)
+
+	assert.Equal(t, "StatefulSet", u.GetKind())
+	assert.Equal(t, "apps/v1", u.GetAPIVersion())
+	assert.Equal(t, "db", u.GetName())
+	assert.Equal(t, "default", u.GetNamespace())
+	assert.Equal(t, UID("123"), u.GetUID())
+	assert.Equal(t, map[string]string{"app": "db"}, u.GetLabels())
+
+	replicas, found, err := NestedInt64(u.Object, "spec", "replicas")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(3), replicas)
+
+	ratio, _, err := NestedFloat64(u.Object, "spec", "ratio")
+	assert.Nil(t, err)
+	assert.Equal(t, 0.5, ratio)
+
+	_, found, err = NestedString(u.Object, "spec", "missing")
+	assert.Nil(t, err)
+	assert.False(t, found)
+
+	_, _, err = NestedString(u.Object, "spec", "replicas")
+	assert.NotNil(t, err)
+
+	_, _, err = NestedString(u.Object, "metadata", "name", "first")
+	assert.NotNil(t, err)
+
+	require.Nil(t, SetNestedField(u.Object, int64(5), "spec", "replicas"))
+	require.Nil(t, SetNestedField(u.Object, []string{"a", "b"}, "spec", "template", "args"))
+	args, found, err := NestedStringSlice(u.Object, "spec", "template", "args")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"a", "b"}, args)
+
+	RemoveNestedField(u.Object, "spec", "paused")
+	_, found, _ = NestedBool(u.Object, "spec", "paused")
+	assert.False(t, found)
+
+	u.SetLabels(nil)
+	assert.Nil(t, u.GetLabels())
+
+	owner := NewReplicaSet("default", "owner")
+	owner.UID = "owner-uid"
+	require.Nil(t, SetControllerReference(owner, &u))
+	assert.True(t, IsControlledBy(&u, owner))
+
+	// copies do not share state
+	c := u.DeepCopy()
+	require.Nil(t, SetNestedField(c.Object, "other", "metadata", "name"))
+	assert.Equal(t, "db", u.GetName())
+}
+
+func TestUnstructuredList(t *testing.T) {
+	in := `{"apiVersion":"v1","kind":"List","metadata":{"resourceVersion":"42"},"items":[{"kind":"Pod","metadata":{"name":"a"}},{"kind":"Pod","metadata":{"name":"b"}}]}`
+
+	var list UnstructuredList
+	require.Nil(t, json.Unmarshal([]byte(in), &list))
+	assert.Equal(t, "42", list.GetResourceVersion())
+	require.Len(t, list.Items, 2)
+	assert.Equal(t, "b", list.Items[1].GetName())
+
+	out, err := json.Marshal(list)
+	require.Nil(t, err)
+	assert.JSONEq(t, in, string(out))
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// VersionInfo is the version of the API server as returned by /version.
+	VersionInfo struct {
+		Major        string `json:"major"`
+		Minor        string `json:"minor"`
+		GitVersion   string `json:"gitVersion"`
+		GitCommit    string `json:"gitCommit"`
+		GitTreeState string `json:"gitTreeState"`
+		BuildDate    string `json:"buildDate"`
+		GoVersion    string `json:"goVersion"`
+		Compiler     string `json:"compiler"`
+		Platform     string `json:"platform"`
+	}
+
+	// Version is a parsed Kubernetes version, such as v1.27.3-gke.100.
+	Version struct {
+		Major int
+		Minor int
+		Patch int
+		// PreRelease is anything after the patch version, without the leading
+		// "-", such as "beta.0" or "gke.100".
+		PreRelease string
+	}
+)
+
+func (info VersionInfo) String() string {
+	return info.GitVersion
+}
+
+// Version parses the version of the server. GitVersion is used if it is set,
+// otherwise Major and Minor. Providers often add a "+" to the minor version,
+// such as "27+", which is ignored.
+func (info VersionInfo) Version() (Version, error) {
+	if info.GitVersion != "" {
+		return ParseVersion(info.GitVersion)
+	}
+	major, err := leadingInt(info.Major)
+	if err != nil {
+		return Version{}, errors.Wrapf(err, "invalid major version %q", info.Major)
+	}
+	minor, err := leadingInt(info.Minor)
+	if err != nil {
+		return Version{}, errors.Wrapf(err, "invalid minor version %q", info.Minor)
+	}
+	return Version{Major: major, Minor: minor}, nil
+}
+
+// AtLeast reports whether the server is at least the given major and minor
+// version. It returns false if the version cannot be parsed.
+func (info VersionInfo) AtLeast(major, minor int) bool {
+	v, err := info.Version()
+	if err != nil {
+		return false
+	}
+	return v.AtLeast(major, minor)
+}
+
+// ParseVersion parses a version such as "v1.27.3" or "1.27.3-gke.100". The
+// leading "v" and the patch version are optional.
+func ParseVersion(s string) (Version, error) {
+	str := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	var v Version
+	if i := strings.IndexAny(str, "-+"); i >= 0 {
+		v.PreRelease = str[i+1:]
+		str = str[:i]
+	}
+
+	parts := strings.Split(str, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Version{}, errors.Errorf("invalid version %q", s)
+	}
+	nums := make([]int, 3)
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return Version{}, errors.Errorf("invalid version %q", s)
+		}
+		nums[i] = n
+	}
+	v.Major, v.Minor, v.Patch = nums[0], nums[1], nums[2]
+	return v, nil
+}
+
+// Compare returns -1, 0 or 1 if v is older than, the same as or newer than
+// other. Pre-release information is ignored.
+func (v Version) Compare(other Version) int {
+	switch {
+	case v.Major != other.Major:
+		return compareInt(v.Major, other.Major)
+	case v.Minor != other.Minor:
+		return compareInt(v.Minor, other.Minor)
+	}
+	return compareInt(v.Patch, other.Patch)
+}
+
+// AtLeast reports whether v is at least the given major and minor version.
+func (v Version) AtLeast(major, minor int) bool {
+	return v.Compare(Version{Major: major, Minor: minor}) >= 0
+}
+
+// LessThan reports whether v is older than other.
+func (v Version) LessThan(other Version) bool {
+	return v.Compare(other) < 0
+}
+
+func (v Version) String() string {
+	s := "v" + strconv.Itoa(v.Major) + "." + strconv.Itoa(v.Minor) + "." + strconv.Itoa(v.Patch)
+	if v.PreRelease != "" {
+		s += "-" + v.PreRelease
+	}
+	return s
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// leadingInt parses the digits at the start of s.
+func leadingInt(s string) (int, error) {
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	return strconv.Atoi(s[:end])
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	v, err := ParseVersion("v1.27.3-gke.100")
+	require.Nil(t, err)
+	assert.Equal(t, Version{Major: 1, Minor: 27, Patch: 3, PreRelease: "gke.100"}, v)
+	assert.Equal(t, "v1.27.3-gke.100", v.String())
+
+	v, err = ParseVersion("1.9")
+	require.Nil(t, err)
+	assert.Equal(t, Version{Major: 1, Minor: 9}, v)
+
+	for _, s := range []string{"", "v1", "1.x.0", "1.2.3.4"} {
+		_, err := ParseVersion(s)
+		assert.NotNil(t, err, s)
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	old := Version{Major: 1, Minor: 9, Patch: 11}
+	current := Version{Major: 1, Minor: 16}
+	assert.True(t, old.LessThan(current))
+	assert.False(t, current.LessThan(old))
+	assert.Equal(t, 0, current.Compare(Version{Major: 1, Minor: 16}))
+	assert.True(t, current.AtLeast(1, 16))
+	assert.False(t, old.AtLeast(1, 10))
+}
+
+func TestVersionInfo(t *testing.T) {
+	info := VersionInfo{Major: "1", Minor: "27+", GitVersion: "v1.27.3-eks-a5565ad"}
+	assert.True(t, info.AtLeast(1, 27))
+	assert.False(t, info.AtLeast(1, 28))
+
+	info.GitVersion = ""
+	v, err := info.Version()
+	require.Nil(t, err)
+	assert.Equal(t, Version{Major: 1, Minor: 27}, v)
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// waitWatchTimeoutSeconds bounds each watch made by WaitFor so that an
+// abandoned watch does not linger on the server.
+const waitWatchTimeoutSeconds = 300
+
+type (
+	// ListWatcher lists and watches a single object, such as a Pod with a
+	// given name.
+	ListWatcher interface {
+		// List returns the matching objects, if any, and the resource version of the list.
+		List() (items []Object, resourceVersion string, err error)
+		// Watch sends changes since resourceVersion into events until the
+		// watch ends. It must close events before returning.
+		Watch(resourceVersion string, events chan<- ObjectEvent) error
+	}
+
+	// ObjectEvent is a watch event with its object decoded.
+	ObjectEvent struct {
+		Type   WatchEventType
+		Object Object
+		// Err is set for events of type WatchEventTypeError.
+		Err error
+	}
+
+	// ConditionFunc reports whether the awaited condition holds for the object.
+	// obj is nil if the object does not exist. Returning an error stops the wait.
+	ConditionFunc func(obj Object) (bool, error)
+)
+
+// WaitFor blocks until condition returns true for the object selected by lw,
+// condition returns an error, or the context is done. It lists the object
+// once and then watches it for changes rather than polling. The last seen
+// version of the object is returned.
+func WaitFor(ctx context.Context, lw ListWatcher, condition ConditionFunc) (Object, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		items, resourceVersion, err := lw.List()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list")
+		}
+
+		var obj Object
+		if len(items) > 0 {
+			obj = items[0]
+		}
+		done, err := condition(obj)
+		if err != nil || done {
+			return obj, err
+		}
+
+		obj, done, err = watchUntil(ctx, lw, resourceVersion, obj, condition)
+		if err != nil || done {
+			return obj, err
+		}
+		// the watch ended or expired, so list again
+	}
+}
+
+func watchUntil(ctx context.Context, lw ListWatcher, resourceVersion string, obj Object, condition ConditionFunc) (Object, bool, error) {
+	events := make(chan ObjectEvent)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- lw.Watch(resourceVersion, events)
+	}()
+
+	// stop reading once we return, but let the watch wind down on its own.
+	defer func() {
+		go func() {
+			for range events {
+			}
+		}()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return obj, false, ctx.Err()
+		case ev, ok := <-events:
+			if !ok {
+				return obj, false, errors.Wrap(<-errc, "failed to watch")
+			}
+			switch ev.Type {
+			case WatchEventTypeError:
+				// typically the resource version is too old
+				return obj, false, nil
+			case WatchEventTypeDeleted:
+				obj = nil
+			default:
+				obj = ev.Object
+			}
+			done, err := condition(obj)
+			if err != nil || done {
+				return obj, done, err
+			}
+		}
+	}
+}
+
+// listWatch implements ListWatcher for a single named object using typed
+// list and watch functions.
+type listWatch struct {
+	name  string
+	list  func(opts *ListOptions) ([]Object, string, error)
+	watch func(opts *WatchOptions, events chan<- ObjectEvent) error
+}
+
+func (lw *listWatch) listOptions() ListOptions {
+	return ListOptions{
+		FieldSelector: FieldSelector{
+			"metadata.name": lw.name,
+		},
+	}
+}
+
+func (lw *listWatch) List() ([]Object, string, error) {
+	opts := lw.listOptions()
+	return lw.list(&opts)
+}
+
+func (lw *listWatch) Watch(resourceVersion string, events chan<- ObjectEvent) error {
+	opts := &WatchOptions{
+		ListOptions:     lw.listOptions(),
+		ResourceVersion: resourceVersion,
+		TimeoutSeconds:  waitWatchTimeoutSeconds,
+	}
+	return lw.watch(opts, events)
+}
+
+// DynamicListWatcher returns a ListWatcher for the named object of the resource.
+func DynamicListWatcher(c DynamicResourceInterface, namespace, name string) ListWatcher {
+	return &listWatch{
+		name: name,
+		list: func(opts *ListOptions) ([]Object, string, error) {
+			list, err := c.List(namespace, opts)
+			if err != nil {
+				return nil, "", err
+			}
+			items := make([]Object, len(list.Items))
+			for i := range list.Items {
+				items[i] = &list.Items[i]
+			}
+			return items, list.GetResourceVersion(), nil
+		},
+		watch: func(opts *WatchOptions, events chan<- ObjectEvent) error {
+			typed := make(chan UnstructuredWatchEvent)
+			go func() {
+				defer close(events)
+				for ev := range typed {
+					obj, err := ev.Object()
+					if err != nil {
+						events <- ObjectEvent{Type: WatchEventTypeError, Err: err}
+						continue
+					}
+					events <- ObjectEvent{Type: ev.Type(), Object: obj}
+				}
+			}()
+			return c.Watch(namespace, opts, typed)
+		},
+	}
+}
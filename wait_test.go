@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testListWatch lists nothing and then replays a fixed set of events.
+type testListWatch struct {
+	events []ObjectEvent
+}
+
+func (lw *testListWatch) List() ([]Object, string, error) {
+	return nil, "1", nil
+}
+
+func (lw *testListWatch) Watch(resourceVersion string, events chan<- ObjectEvent) error {
+	defer close(events)
+	for _, ev := range lw.events {
+		events <- ev
+	}
+	return nil
+}
+
+func TestWaitForPodPhase(t *testing.T) {
+	pending := &Pod{ObjectMeta: NewObjectMeta("default", "web"), Status: &PodStatus{Phase: PodPending}}
+	running := &Pod{ObjectMeta: NewObjectMeta("default", "web"), Status: &PodStatus{Phase: PodRunning}}
+	lw := &testListWatch{
+		events: []ObjectEvent{
+			{Type: WatchEventTypeAdded, Object: pending},
+			{Type: WatchEventTypeModified, Object: running},
+		},
+	}
+
+	obj, err := WaitFor(context.Background(), lw, PodPhaseCondition(PodRunning))
+	require.Nil(t, err)
+	assert.Equal(t, running, obj)
+}
+
+func TestWaitForDeleted(t *testing.T) {
+	ns := NewNamespace("test")
+	lw := &testListWatch{
+		events: []ObjectEvent{
+			{Type: WatchEventTypeAdded, Object: ns},
+			{Type: WatchEventTypeDeleted, Object: ns},
+		},
+	}
+
+	// the object does not exist when first listed
+	obj, err := WaitFor(context.Background(), lw, DeletedCondition())
+	require.Nil(t, err)
+	assert.Nil(t, obj)
+
+	done := false
+	condition := func(obj Object) (bool, error) {
+		if obj != nil {
+			done = true
+		}
+		return done && obj == nil, nil
+	}
+	obj, err = WaitFor(context.Background(), lw, condition)
+	require.Nil(t, err)
+	assert.Nil(t, obj)
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	lw := &testListWatch{}
+	_, err := WaitFor(ctx, lw, PodReadyCondition())
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestWaitForPersistentVolumeClaimBound(t *testing.T) {
+	pending := NewPersistentVolumeClaim("default", "data")
+	pending.Status = &PersistentVolumeClaimStatus{Phase: ClaimPending}
+	bound := NewPersistentVolumeClaim("default", "data")
+	bound.Status = &PersistentVolumeClaimStatus{Phase: ClaimBound}
+	lw := &testListWatch{
+		events: []ObjectEvent{
+			{Type: WatchEventTypeAdded, Object: pending},
+			{Type: WatchEventTypeModified, Object: bound},
+		},
+	}
+
+	obj, err := WaitFor(context.Background(), lw, PersistentVolumeClaimBoundCondition())
+	require.Nil(t, err)
+	assert.Equal(t, bound, obj)
+
+	lost := NewPersistentVolumeClaim("default", "data")
+	lost.Status = &PersistentVolumeClaimStatus{Phase: ClaimLost}
+	lw = &testListWatch{
+		events: []ObjectEvent{
+			{Type: WatchEventTypeAdded, Object: lost},
+		},
+	}
+	_, err = WaitFor(context.Background(), lw, PersistentVolumeClaimBoundCondition())
+	assert.NotNil(t, err)
+}
+
+func TestWaitForCustomResourceDefinitionEstablished(t *testing.T) {
+	crd := NewCustomResourceDefinition("stable.example.com", "crontabs", "CronTab", NamespaceScoped)
+	crd.Spec.Versions = []CustomResourceDefinitionVersion{
+		{Name: "v1beta1", Served: true},
+		{Name: "v1", Served: true, Storage: true},
+		{Name: "v1alpha1"},
+	}
+	assert.Equal(t, "crontabs.stable.example.com", crd.Name)
+	assert.Equal(t, []string{"stable.example.com/v1", "stable.example.com/v1beta1"}, crd.GroupVersions())
+
+	established := *crd
+	established.Status = &CustomResourceDefinitionStatus{Conditions: []CustomResourceDefinitionCondition{
+		{Type: CustomResourceDefinitionNamesAccepted, Status: ConditionTrue},
+		{Type: CustomResourceDefinitionEstablished, Status: ConditionTrue},
+	}}
+	lw := &testListWatch{
+		events: []ObjectEvent{
+			{Type: WatchEventTypeAdded, Object: crd},
+			{Type: WatchEventTypeModified, Object: &established},
+		},
+	}
+
+	obj, err := WaitFor(context.Background(), lw, CustomResourceDefinitionEstablishedCondition())
+	require.Nil(t, err)
+	assert.Equal(t, &established, obj)
+
+	conflict := *crd
+	conflict.Status = &CustomResourceDefinitionStatus{Conditions: []CustomResourceDefinitionCondition{
+		{Type: CustomResourceDefinitionNamesAccepted, Status: ConditionFalse, Message: `"crontabs" is already in use`},
+	}}
+	lw = &testListWatch{
+		events: []ObjectEvent{
+			{Type: WatchEventTypeAdded, Object: &conflict},
+		},
+	}
+	_, err = WaitFor(context.Background(), lw, CustomResourceDefinitionEstablishedCondition())
+	assert.NotNil(t, err)
+}